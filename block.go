@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -21,6 +22,19 @@ type BlockService interface {
 	GetChildren(context.Context, BlockID, *Pagination) (*GetChildrenResponse, error)
 	Update(ctx context.Context, id BlockID, request *BlockUpdateRequest) (Block, error)
 	Delete(context.Context, BlockID) (Block, error)
+	GetTree(context.Context, BlockID, *TreeOptions) (Blocks, error)
+	AppendTree(ctx context.Context, id BlockID, after BlockID, blocks Blocks) (Blocks, error)
+	Sync(ctx context.Context, id BlockID, desired Blocks, opts *SyncOptions) (*SyncPlan, error)
+	UpdateBlock(context.Context, Block) (Block, error)
+	MoveBlock(ctx context.Context, id BlockID, newParent BlockID, after BlockID) (*MoveBlockResult, error)
+	GetSyncedOriginal(context.Context, BlockID) (*SyncedBlock, error)
+	CreateSyncedBlock(ctx context.Context, parent BlockID, after BlockID, children Blocks) (*SyncedBlock, error)
+	InsertSyncedReference(ctx context.Context, original BlockID, parent BlockID, after BlockID) (*SyncedBlock, error)
+	ReadTable(context.Context, BlockID) (*TableView, error)
+	WriteTable(ctx context.Context, parent BlockID, after BlockID, rows [][]string, header bool) (*TableView, error)
+	UpdateCell(ctx context.Context, table *TableView, row, column int, value string) error
+	AppendRow(ctx context.Context, table *TableView, cells ...string) error
+	ReplaceRows(ctx context.Context, table *TableView, rows [][]string) error
 }
 
 type BlockClient struct {
@@ -39,6 +53,13 @@ type BlockClient struct {
 //
 // See https://developers.notion.com/reference/patch-block-children
 func (bc *BlockClient) AppendChildren(ctx context.Context, id BlockID, requestBody *AppendBlockChildrenRequest) (*AppendBlockChildrenResponse, error) {
+	if bc.apiClient.autoFixLimits && len(requestBody.Children) > MaxArrayLength {
+		return bc.appendChildrenInChunks(ctx, id, requestBody)
+	}
+	if err := bc.apiClient.prepareRequest(requestBody); err != nil {
+		return nil, err
+	}
+
 	res, err := bc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("blocks/%s/children", id.String()), nil, requestBody)
 	if err != nil {
 		return nil, err
@@ -58,11 +79,43 @@ func (bc *BlockClient) AppendChildren(ctx context.Context, id BlockID, requestBo
 	return &response, nil
 }
 
+// appendChildrenInChunks sends the children of requestBody in chunks of
+// MaxArrayLength blocks, each one inserted after the last block of the
+// previous chunk. If a chunk fails, the response holds the blocks appended
+// by the previous ones.
+func (bc *BlockClient) appendChildrenInChunks(ctx context.Context, id BlockID, requestBody *AppendBlockChildrenRequest) (*AppendBlockChildrenResponse, error) {
+	response := &AppendBlockChildrenResponse{Object: ObjectTypeList}
+	after := requestBody.After
+	for _, chunk := range chunkBlocks(requestBody.Children) {
+		res, err := bc.AppendChildren(ctx, id, &AppendBlockChildrenRequest{After: after, Children: chunk})
+		if err != nil {
+			return response, err
+		}
+		response.Results = append(response.Results, res.Results...)
+		if n := len(res.Results); after != "" && n > 0 {
+			after = res.Results[n-1].GetID()
+		}
+	}
+	return response, nil
+}
+
 type AppendBlockChildrenRequest struct {
 	// Append new children after a specific block. If empty, new children with be appended to the bottom of the parent block.
 	After BlockID `json:"after,omitempty"`
 	// Child content to append to a container block as an array of block objects.
-	Children []Block `json:"children"`
+	Children Blocks `json:"children"`
+}
+
+// MarshalJSON leaves out the read-only fields of the children, so blocks read
+// from the API can be appended again.
+func (r AppendBlockChildrenRequest) MarshalJSON() ([]byte, error) {
+	type alias AppendBlockChildrenRequest
+	children, err := newBlocks(r.Children)
+	if err != nil {
+		return nil, err
+	}
+	r.Children = children
+	return json.Marshal(alias(r))
 }
 
 // Get Retrieves a Block object using the ID specified.
@@ -129,6 +182,10 @@ type GetChildrenResponse struct {
 //
 // See https://developers.notion.com/reference/update-a-block
 func (bc *BlockClient) Update(ctx context.Context, id BlockID, requestBody *BlockUpdateRequest) (Block, error) {
+	if err := bc.apiClient.prepareRequest(requestBody); err != nil {
+		return nil, err
+	}
+
 	res, err := bc.apiClient.request(ctx, http.MethodPatch, fmt.Sprintf("blocks/%s", id.String()), nil, requestBody)
 	if err != nil {
 		return nil, err
@@ -149,26 +206,37 @@ func (bc *BlockClient) Update(ctx context.Context, id BlockID, requestBody *Bloc
 }
 
 type BlockUpdateRequest struct {
-	Paragraph        *Paragraph `json:"paragraph,omitempty"`
-	Heading1         *Heading   `json:"heading_1,omitempty"`
-	Heading2         *Heading   `json:"heading_2,omitempty"`
-	Heading3         *Heading   `json:"heading_3,omitempty"`
-	BulletedListItem *ListItem  `json:"bulleted_list_item,omitempty"`
-	NumberedListItem *ListItem  `json:"numbered_list_item,omitempty"`
-	Code             *Code      `json:"code,omitempty"`
-	ToDo             *ToDo      `json:"to_do,omitempty"`
-	Toggle           *Toggle    `json:"toggle,omitempty"`
-	Embed            *Embed     `json:"embed,omitempty"`
-	Image            *Image     `json:"image,omitempty"`
-	Video            *Video     `json:"video,omitempty"`
-	File             *BlockFile `json:"file,omitempty"`
-	Pdf              *Pdf       `json:"pdf,omitempty"`
-	Bookmark         *Bookmark  `json:"bookmark,omitempty"`
-	Template         *Template  `json:"template,omitempty"`
-	Callout          *Callout   `json:"callout,omitempty"`
-	Equation         *Equation  `json:"equation,omitempty"`
-	Quote            *Quote     `json:"quote,omitempty"`
-	TableRow         *TableRow  `json:"table_row,omitempty"`
+	Paragraph        *Paragraph        `json:"paragraph,omitempty"`
+	Heading1         *Heading          `json:"heading_1,omitempty"`
+	Heading2         *Heading          `json:"heading_2,omitempty"`
+	Heading3         *Heading          `json:"heading_3,omitempty"`
+	BulletedListItem *ListItem         `json:"bulleted_list_item,omitempty"`
+	NumberedListItem *ListItem         `json:"numbered_list_item,omitempty"`
+	Code             *Code             `json:"code,omitempty"`
+	ToDo             *ToDo             `json:"to_do,omitempty"`
+	Toggle           *Toggle           `json:"toggle,omitempty"`
+	Embed            *Embed            `json:"embed,omitempty"`
+	Image            *Image            `json:"image,omitempty"`
+	Video            *Video            `json:"video,omitempty"`
+	File             *BlockFile        `json:"file,omitempty"`
+	Pdf              *Pdf              `json:"pdf,omitempty"`
+	Bookmark         *Bookmark         `json:"bookmark,omitempty"`
+	Template         *Template         `json:"template,omitempty"`
+	Callout          *Callout          `json:"callout,omitempty"`
+	Equation         *Equation         `json:"equation,omitempty"`
+	Quote            *Quote            `json:"quote,omitempty"`
+	TableRow         *TableRow         `json:"table_row,omitempty"`
+	Table            *TableUpdate      `json:"table,omitempty"`
+	Divider          *Divider          `json:"divider,omitempty"`
+	Audio            *Audio            `json:"audio,omitempty"`
+	Breadcrumb       *Breadcrumb       `json:"breadcrumb,omitempty"`
+	TableOfContents  *TableOfContents  `json:"table_of_contents,omitempty"`
+	LinkToPage       *LinkToPage       `json:"link_to_page,omitempty"`
+	SyncedBlock      *Synced           `json:"synced_block,omitempty"`
+	ColumnList       *ColumnListUpdate `json:"column_list,omitempty"`
+	// Archived archives the block when set to true and restores an archived
+	// block when set to false.
+	Archived *bool `json:"archived,omitempty"`
 }
 
 // Delete Sets a Block object, including page blocks, to archived: true using the ID
@@ -220,6 +288,29 @@ type Block interface {
 
 type Blocks []Block
 
+// MarshalJSON marshals the blocks together with the fields the API returned
+// for them that their types do not model, so blocks read from the API can be
+// modified and sent again without losing data. Nil blocks are encoded as an
+// empty list, since the API rejects null children, e.g. of a column.
+func (b Blocks) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("[]"), nil
+	}
+	items := make([]json.RawMessage, len(b))
+	for i, block := range b {
+		if block == nil {
+			items[i] = json.RawMessage("null")
+			continue
+		}
+		data, err := marshalBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = data
+	}
+	return json.Marshal(items)
+}
+
 func (b *Blocks) UnmarshalJSON(data []byte) error {
 	var err error
 	mapArr := make([]map[string]any, 0)
@@ -252,6 +343,9 @@ type BasicBlock struct {
 	HasChildren    bool       `json:"has_children,omitempty"`
 	Archived       bool       `json:"archived,omitempty"`
 	Parent         *Parent    `json:"parent,omitempty"`
+
+	// unknown holds fields returned by the API that the block does not model.
+	unknown *unknownFields
 }
 
 func (b BasicBlock) GetType() BlockType {
@@ -293,12 +387,20 @@ func (b BasicBlock) GetArchived() bool {
 func (b BasicBlock) GetParent() *Parent {
 	return b.Parent
 }
+
+// concatenateRichText returns the plain text of richtext. Unlike PlainText
+// it leaves richtext unchanged, falling back to the content of objects built
+// locally that have no PlainText yet.
 func concatenateRichText(richtext []RichText) string {
-	var result string
-	for _, rt := range richtext {
-		result += rt.PlainText
+	var result strings.Builder
+	for i := range richtext {
+		if text := richtext[i].PlainText; text != "" {
+			result.WriteString(text)
+		} else {
+			result.WriteString(plainTextOf(&richtext[i]))
+		}
 	}
-	return result
+	return result.String()
 }
 
 func (h Heading1Block) GetRichTextString() string {
@@ -381,8 +483,32 @@ func (b EquationBlock) GetRichTextString() string {
 	return b.Equation.Expression
 }
 
+func (b CodeBlock) GetRichTextString() string {
+	return concatenateRichText(b.Code.RichText)
+}
+
+// GetRichTextString returns the text of every cell of the row, separated by
+// tabs.
+func (b TableRowBlock) GetRichTextString() string {
+	cells := make([]string, len(b.TableRow.Cells))
+	for i, cell := range b.TableRow.Cells {
+		cells[i] = concatenateRichText(cell)
+	}
+	return strings.Join(cells, "\t")
+}
+
+func (b ChildPageBlock) GetRichTextString() string {
+	return b.ChildPage.Title
+}
+
+func (b ChildDatabaseBlock) GetRichTextString() string {
+	return b.ChildDatabase.Title
+}
+
+// GetRichTextString returns an empty string. Block types holding text
+// override it.
 func (b BasicBlock) GetRichTextString() string {
-	return "No rich text of a basic block."
+	return ""
 }
 
 var _ Block = (*BasicBlock)(nil)
@@ -455,6 +581,13 @@ type Table struct {
 	Children        Blocks `json:"children,omitempty"`
 }
 
+// TableUpdate holds the table settings that can be changed by an update.
+// The width of a table is fixed once the table is created.
+type TableUpdate struct {
+	HasColumnHeader bool `json:"has_column_header"`
+	HasRowHeader    bool `json:"has_row_header"`
+}
+
 type TableRowBlock struct {
 	BasicBlock
 	TableRow TableRow `json:"table_row"`
@@ -505,9 +638,13 @@ type Toggle struct {
 
 type ChildPageBlock struct {
 	BasicBlock
-	ChildPage struct {
-		Title string `json:"title"`
-	} `json:"child_page"`
+	ChildPage ChildPage `json:"child_page"`
+}
+
+// ChildPage holds the title of a page nested in another page. The content of
+// the page is retrieved through the block children of the ChildPageBlock.
+type ChildPage struct {
+	Title string `json:"title"`
 }
 
 type EmbedBlock struct {
@@ -534,11 +671,17 @@ type Image struct {
 
 // GetURL returns the external or internal URL depending on the image type.
 func (i Image) GetURL() string {
-	if i.File != nil {
-		return i.File.URL
+	return fileURL(i.File, i.External)
+}
+
+// fileURL returns the URL of the file hosted by Notion or, if there is none,
+// of the external file.
+func fileURL(file, external *FileObject) string {
+	if file != nil {
+		return file.URL
 	}
-	if i.External != nil {
-		return i.External.URL
+	if external != nil {
+		return external.URL
 	}
 	return ""
 }
@@ -557,13 +700,7 @@ type Audio struct {
 
 // GetURL returns the external or internal URL depending on the image type.
 func (i Audio) GetURL() string {
-	if i.File != nil {
-		return i.File.URL
-	}
-	if i.External != nil {
-		return i.External.URL
-	}
-	return ""
+	return fileURL(i.File, i.External)
 }
 
 type CodeBlock struct {
@@ -589,6 +726,11 @@ type Video struct {
 	External *FileObject `json:"external,omitempty"`
 }
 
+// GetURL returns the external or internal URL depending on the video type.
+func (v Video) GetURL() string {
+	return fileURL(v.File, v.External)
+}
+
 type FileBlock struct {
 	BasicBlock
 	File BlockFile `json:"file"`
@@ -625,9 +767,13 @@ type Bookmark struct {
 
 type ChildDatabaseBlock struct {
 	BasicBlock
-	ChildDatabase struct {
-		Title string `json:"title"`
-	} `json:"child_database"`
+	ChildDatabase ChildDatabase `json:"child_database"`
+}
+
+// ChildDatabase holds the title of a database nested in a page. The schema
+// and rows are retrieved through DatabaseService using the block ID.
+type ChildDatabase struct {
+	Title string `json:"title"`
 }
 
 type TableOfContentsBlock struct {
@@ -688,6 +834,12 @@ type ColumnList struct {
 	Children Blocks `json:"children"`
 }
 
+// ColumnListUpdate is the empty payload of a column list update; its columns
+// are changed through the block children endpoints.
+type ColumnListUpdate struct {
+	// empty
+}
+
 // NOTE: will only be returned by the API. Cannot be created by the API.
 // https://developers.notion.com/reference/block#link-preview-blocks
 type LinkPreviewBlock struct {
@@ -732,13 +884,31 @@ type Synced struct {
 }
 
 type SyncedFrom struct {
-	BlockID BlockID `json:"block_id"`
+	Type    BlockType `json:"type,omitempty"`
+	BlockID BlockID   `json:"block_id"`
 }
 
+// UnsupportedBlock is returned for blocks the Notion API reports with type
+// "unsupported".
 type UnsupportedBlock struct {
 	BasicBlock
 }
 
+// UnknownBlock is returned for block types this package does not know yet.
+// Raw holds the block exactly as returned by the API and is used again when
+// the block is marshaled, so unknown blocks survive a round-trip.
+type UnknownBlock struct {
+	BasicBlock
+	Raw json.RawMessage `json:"-"`
+}
+
+func (b UnknownBlock) MarshalJSON() ([]byte, error) {
+	if len(b.Raw) > 0 {
+		return b.Raw, nil
+	}
+	return json.Marshal(b.BasicBlock)
+}
+
 type AppendBlockChildrenResponse struct {
 	Object  ObjectType `json:"object"`
 	Results []Block    `json:"results"`
@@ -771,8 +941,13 @@ func (r *AppendBlockChildrenResponse) UnmarshalJSON(data []byte) error {
 }
 
 func decodeBlock(raw map[string]any) (Block, error) {
+	t, ok := raw["type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("block without type: %v", raw["id"])
+	}
+
 	var b Block
-	switch BlockType(raw["type"].(string)) {
+	switch BlockType(t) {
 	case BlockTypeParagraph:
 		b = &ParagraphBlock{}
 	case BlockTypeHeading1:
@@ -803,6 +978,8 @@ func decodeBlock(raw map[string]any) (Block, error) {
 		b = &ImageBlock{}
 	case BlockTypeVideo:
 		b = &VideoBlock{}
+	case BlockTypeAudio:
+		b = &AudioBlock{}
 	case BlockTypeFile:
 		b = &FileBlock{}
 	case BlockTypePdf:
@@ -835,17 +1012,22 @@ func decodeBlock(raw map[string]any) (Block, error) {
 		b = &TableBlock{}
 	case BlockTypeTableRowBlock:
 		b = &TableRowBlock{}
-
 	case BlockTypeUnsupported:
 		b = &UnsupportedBlock{}
 	default:
-		return &UnsupportedBlock{}, nil
+		b = &UnknownBlock{}
 	}
 	j, err := json.Marshal(raw)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(j, b)
-	return b, err
+	if err = json.Unmarshal(j, b); err != nil {
+		return nil, err
+	}
+	if unknown, ok := b.(*UnknownBlock); ok {
+		unknown.Raw = j
+		return b, nil
+	}
+	return b, collectUnknownFields(b, raw)
 }
@@ -0,0 +1,310 @@
+package notionapi
+
+// This file contains constructors for blocks that can be created through the
+// API. Every constructor sets the object and type of the block, so the result
+// can be used as-is in AppendBlockChildrenRequest.Children or
+// PageCreateRequest.Children.
+
+func newBasicBlock(t BlockType) BasicBlock {
+	return BasicBlock{Object: ObjectTypeBlock, Type: t}
+}
+
+// textRichText converts s into rich text, split into as many text objects as
+// needed to stay below MaxRichTextContentLength.
+func textRichText(s string) []RichText {
+	segments := SplitText(s, MaxRichTextContentLength)
+	result := make([]RichText, len(segments))
+	for i, segment := range segments {
+		result[i] = RichText{Type: RichTextTypeText, Text: &Text{Content: segment}}
+	}
+	return result
+}
+
+func richTextOrEmpty(rt []RichText) []RichText {
+	if rt == nil {
+		return []RichText{}
+	}
+	return rt
+}
+
+// NewParagraph returns a paragraph block.
+func NewParagraph(rt ...RichText) *ParagraphBlock {
+	return &ParagraphBlock{
+		BasicBlock: newBasicBlock(BlockTypeParagraph),
+		Paragraph:  Paragraph{RichText: richTextOrEmpty(rt)},
+	}
+}
+
+// NewHeading returns a *Heading1Block, *Heading2Block or *Heading3Block
+// depending on level. Levels below 1 are treated as 1 and levels above 3 as 3.
+func NewHeading(level int, rt ...RichText) Block {
+	return newHeading(level, Heading{RichText: richTextOrEmpty(rt)})
+}
+
+// NewToggleHeading returns a toggleable heading of the given level whose
+// content is hidden under the heading.
+func NewToggleHeading(level int, title []RichText, children ...Block) Block {
+	return newHeading(level, Heading{
+		RichText:     richTextOrEmpty(title),
+		Children:     children,
+		IsToggleable: true,
+	})
+}
+
+func newHeading(level int, h Heading) Block {
+	switch {
+	case level <= 1:
+		return &Heading1Block{BasicBlock: newBasicBlock(BlockTypeHeading1), Heading1: h}
+	case level == 2:
+		return &Heading2Block{BasicBlock: newBasicBlock(BlockTypeHeading2), Heading2: h}
+	default:
+		return &Heading3Block{BasicBlock: newBasicBlock(BlockTypeHeading3), Heading3: h}
+	}
+}
+
+// NewBulletedListItem returns a bulleted list item block.
+func NewBulletedListItem(rt ...RichText) *BulletedListItemBlock {
+	return &BulletedListItemBlock{
+		BasicBlock:       newBasicBlock(BlockTypeBulletedListItem),
+		BulletedListItem: ListItem{RichText: richTextOrEmpty(rt)},
+	}
+}
+
+// NewNumberedListItem returns a numbered list item block.
+func NewNumberedListItem(rt ...RichText) *NumberedListItemBlock {
+	return &NumberedListItemBlock{
+		BasicBlock:       newBasicBlock(BlockTypeNumberedListItem),
+		NumberedListItem: ListItem{RichText: richTextOrEmpty(rt)},
+	}
+}
+
+// NewToDo returns a to-do block.
+func NewToDo(checked bool, rt ...RichText) *ToDoBlock {
+	return &ToDoBlock{
+		BasicBlock: newBasicBlock(BlockTypeToDo),
+		ToDo:       ToDo{RichText: richTextOrEmpty(rt), Checked: checked},
+	}
+}
+
+// NewToggle returns a toggle block with the given title and children.
+func NewToggle(title string, children ...Block) *ToggleBlock {
+	return &ToggleBlock{
+		BasicBlock: newBasicBlock(BlockTypeToggle),
+		Toggle:     Toggle{RichText: textRichText(title), Children: children},
+	}
+}
+
+// NewQuote returns a quote block.
+func NewQuote(rt ...RichText) *QuoteBlock {
+	return &QuoteBlock{
+		BasicBlock: newBasicBlock(BlockTypeQuote),
+		Quote:      Quote{RichText: richTextOrEmpty(rt)},
+	}
+}
+
+// NewCallout returns a callout block. icon may be nil, in which case Notion
+// uses its default icon.
+func NewCallout(icon *Icon, rt ...RichText) *CalloutBlock {
+	return &CalloutBlock{
+		BasicBlock: newBasicBlock(BlockTypeCallout),
+		Callout:    Callout{RichText: richTextOrEmpty(rt), Icon: icon},
+	}
+}
+
+// NewEmojiIcon returns an emoji icon, e.g. for NewCallout.
+func NewEmojiIcon(emoji string) *Icon {
+	e := Emoji(emoji)
+	return &Icon{Type: FileTypeEmoji, Emoji: &e}
+}
+
+// NewCode returns a code block containing source. language is normalized
+// with NormalizeCodeLanguage, so aliases like "golang" or "yml" can be used;
+// languages Notion does not support are replaced with "plain text".
+func NewCode(language, source string) *CodeBlock {
+	l, ok := NormalizeCodeLanguage(language)
+	if !ok {
+		l = CodeLanguagePlainText
+	}
+	return &CodeBlock{
+		BasicBlock: newBasicBlock(BlockTypeCode),
+		Code:       Code{RichText: textRichText(source), Language: l.String()},
+	}
+}
+
+// NewEquation returns a block equation.
+func NewEquation(expression string) *EquationBlock {
+	return &EquationBlock{
+		BasicBlock: newBasicBlock(BlockTypeEquation),
+		Equation:   Equation{Expression: expression},
+	}
+}
+
+// NewDivider returns a divider block.
+func NewDivider() *DividerBlock {
+	return &DividerBlock{BasicBlock: newBasicBlock(BlockTypeDivider)}
+}
+
+// NewBreadcrumb returns a breadcrumb block.
+func NewBreadcrumb() *BreadcrumbBlock {
+	return &BreadcrumbBlock{BasicBlock: newBasicBlock(BlockTypeBreadcrumb)}
+}
+
+// NewTableOfContents returns a table of contents block.
+func NewTableOfContents() *TableOfContentsBlock {
+	return &TableOfContentsBlock{BasicBlock: newBasicBlock(BlockTypeTableOfContents)}
+}
+
+// NewEmbed returns an embed block for url.
+func NewEmbed(url string) *EmbedBlock {
+	return &EmbedBlock{
+		BasicBlock: newBasicBlock(BlockTypeEmbed),
+		Embed:      Embed{URL: url},
+	}
+}
+
+// NewBookmark returns a bookmark block for url.
+func NewBookmark(url string) *BookmarkBlock {
+	return &BookmarkBlock{
+		BasicBlock: newBasicBlock(BlockTypeBookmark),
+		Bookmark:   Bookmark{URL: url},
+	}
+}
+
+// NewImage returns an image block showing the external file at url.
+func NewImage(url string) *ImageBlock {
+	return &ImageBlock{
+		BasicBlock: newBasicBlock(BlockTypeImage),
+		Image:      Image{Type: FileTypeExternal, External: &FileObject{URL: url}},
+	}
+}
+
+// NewVideo returns a video block for the external file at url.
+func NewVideo(url string) *VideoBlock {
+	return &VideoBlock{
+		BasicBlock: newBasicBlock(BlockTypeVideo),
+		Video:      Video{Type: FileTypeExternal, External: &FileObject{URL: url}},
+	}
+}
+
+// NewAudio returns an audio block for the external file at url.
+func NewAudio(url string) *AudioBlock {
+	return &AudioBlock{
+		BasicBlock: newBasicBlock(BlockTypeAudio),
+		Audio:      Audio{Type: FileTypeExternal, External: &FileObject{URL: url}},
+	}
+}
+
+// NewFile returns a file block for the external file at url.
+func NewFile(url string) *FileBlock {
+	return &FileBlock{
+		BasicBlock: newBasicBlock(BlockTypeFile),
+		File:       BlockFile{Type: FileTypeExternal, External: &FileObject{URL: url}},
+	}
+}
+
+// NewPdf returns a PDF block for the external file at url.
+func NewPdf(url string) *PdfBlock {
+	return &PdfBlock{
+		BasicBlock: newBasicBlock(BlockTypePdf),
+		Pdf:        Pdf{Type: FileTypeExternal, External: &FileObject{URL: url}},
+	}
+}
+
+// NewLinkToPage returns a block linking to the page with the given ID.
+func NewLinkToPage(id PageID) *LinkToPageBlock {
+	return &LinkToPageBlock{
+		BasicBlock: newBasicBlock(BlockTypeLinkToPage),
+		LinkToPage: LinkToPage{Type: "page_id", PageID: id},
+	}
+}
+
+// NewLinkToDatabase returns a block linking to the database with the given ID.
+func NewLinkToDatabase(id DatabaseID) *LinkToPageBlock {
+	return &LinkToPageBlock{
+		BasicBlock: newBasicBlock(BlockTypeLinkToPage),
+		LinkToPage: LinkToPage{Type: "database_id", DatabaseID: id},
+	}
+}
+
+// NewTable returns a table block holding rows of plain text cells. The table
+// is as wide as its longest row; shorter rows are padded with empty cells. If
+// header is true the first row is displayed as the column header.
+func NewTable(rows [][]string, header bool) *TableBlock {
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	children := make(Blocks, len(rows))
+	for i, row := range rows {
+		cells := make([][]RichText, width)
+		for j := range cells {
+			if j < len(row) && row[j] != "" {
+				cells[j] = textRichText(row[j])
+			} else {
+				cells[j] = []RichText{}
+			}
+		}
+		children[i] = NewTableRow(cells...)
+	}
+
+	return &TableBlock{
+		BasicBlock: newBasicBlock(BlockTypeTableBlock),
+		Table: Table{
+			TableWidth:      width,
+			HasColumnHeader: header,
+			Children:        children,
+		},
+	}
+}
+
+// NewTableRow returns a table row block. It must be appended to a table
+// whose width equals the number of cells.
+func NewTableRow(cells ...[]RichText) *TableRowBlock {
+	return &TableRowBlock{
+		BasicBlock: newBasicBlock(BlockTypeTableRowBlock),
+		TableRow:   TableRow{Cells: cells},
+	}
+}
+
+// NewColumns returns a column list with one column per argument. Notion
+// requires at least two columns, each holding at least one block.
+func NewColumns(columns ...[]Block) *ColumnListBlock {
+	children := make(Blocks, len(columns))
+	for i, column := range columns {
+		children[i] = NewColumn(column...)
+	}
+	return &ColumnListBlock{
+		BasicBlock: newBasicBlock(BlockTypeColumnList),
+		ColumnList: ColumnList{Children: children},
+	}
+}
+
+// NewColumn returns a single column. Columns can only be appended to a
+// column list; see NewColumns.
+func NewColumn(children ...Block) *ColumnBlock {
+	return &ColumnBlock{
+		BasicBlock: newBasicBlock(BlockTypeColumn),
+		Column:     Column{Children: children},
+	}
+}
+
+// NewSyncedOriginal returns an original synced block holding children. Its
+// content can be shown elsewhere with NewSyncedReference.
+func NewSyncedOriginal(children ...Block) *SyncedBlock {
+	return &SyncedBlock{
+		BasicBlock:  newBasicBlock(BlockTypeSyncedBlock),
+		SyncedBlock: Synced{Children: children},
+	}
+}
+
+// NewSyncedReference returns a synced block showing the content of the
+// original synced block with the given ID.
+func NewSyncedReference(original BlockID) *SyncedBlock {
+	return &SyncedBlock{
+		BasicBlock:  newBasicBlock(BlockTypeSyncedBlock),
+		SyncedBlock: Synced{SyncedFrom: &SyncedFrom{Type: "block_id", BlockID: original}},
+	}
+}
@@ -0,0 +1,201 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestBlockConstructors(t *testing.T) {
+	text := func(s string) notionapi.RichText {
+		return notionapi.RichText{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: s}}
+	}
+
+	tests := []struct {
+		name  string
+		block notionapi.Block
+		want  string
+	}{
+		{
+			name:  "paragraph",
+			block: notionapi.NewParagraph(text("hi")),
+			want:  `{"object":"block","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"hi"}}]}}`,
+		},
+		{
+			name:  "empty paragraph",
+			block: notionapi.NewParagraph(),
+			want:  `{"object":"block","type":"paragraph","paragraph":{"rich_text":[]}}`,
+		},
+		{
+			name:  "heading 1",
+			block: notionapi.NewHeading(1, text("h")),
+			want:  `{"object":"block","type":"heading_1","heading_1":{"rich_text":[{"type":"text","text":{"content":"h"}}]}}`,
+		},
+		{
+			name:  "heading 2",
+			block: notionapi.NewHeading(2, text("h")),
+			want:  `{"object":"block","type":"heading_2","heading_2":{"rich_text":[{"type":"text","text":{"content":"h"}}]}}`,
+		},
+		{
+			name:  "heading level is clamped",
+			block: notionapi.NewHeading(7, text("h")),
+			want:  `{"object":"block","type":"heading_3","heading_3":{"rich_text":[{"type":"text","text":{"content":"h"}}]}}`,
+		},
+		{
+			name:  "toggle heading",
+			block: notionapi.NewToggleHeading(2, []notionapi.RichText{text("h")}, notionapi.NewDivider()),
+			want:  `{"object":"block","type":"heading_2","heading_2":{"rich_text":[{"type":"text","text":{"content":"h"}}],"children":[{"object":"block","type":"divider","divider":{}}],"is_toggleable":true}}`,
+		},
+		{
+			name:  "bulleted list item",
+			block: notionapi.NewBulletedListItem(text("a")),
+			want:  `{"object":"block","type":"bulleted_list_item","bulleted_list_item":{"rich_text":[{"type":"text","text":{"content":"a"}}]}}`,
+		},
+		{
+			name:  "numbered list item",
+			block: notionapi.NewNumberedListItem(text("a")),
+			want:  `{"object":"block","type":"numbered_list_item","numbered_list_item":{"rich_text":[{"type":"text","text":{"content":"a"}}]}}`,
+		},
+		{
+			name:  "to do",
+			block: notionapi.NewToDo(true, text("done")),
+			want:  `{"object":"block","type":"to_do","to_do":{"rich_text":[{"type":"text","text":{"content":"done"}}],"checked":true}}`,
+		},
+		{
+			name:  "toggle",
+			block: notionapi.NewToggle("more", notionapi.NewParagraph(text("hidden"))),
+			want:  `{"object":"block","type":"toggle","toggle":{"rich_text":[{"type":"text","text":{"content":"more"}}],"children":[{"object":"block","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"hidden"}}]}}]}}`,
+		},
+		{
+			name:  "quote",
+			block: notionapi.NewQuote(text("q")),
+			want:  `{"object":"block","type":"quote","quote":{"rich_text":[{"type":"text","text":{"content":"q"}}]}}`,
+		},
+		{
+			name:  "callout",
+			block: notionapi.NewCallout(notionapi.NewEmojiIcon("💡"), text("note")),
+			want:  `{"object":"block","type":"callout","callout":{"rich_text":[{"type":"text","text":{"content":"note"}}],"icon":{"type":"emoji","emoji":"💡"}}}`,
+		},
+		{
+			name:  "code",
+			block: notionapi.NewCode("go", "package main"),
+			want:  `{"object":"block","type":"code","code":{"rich_text":[{"type":"text","text":{"content":"package main"}}],"language":"go"}}`,
+		},
+		{
+			name:  "code with language alias",
+			block: notionapi.NewCode("Golang", "x"),
+			want:  `{"object":"block","type":"code","code":{"rich_text":[{"type":"text","text":{"content":"x"}}],"language":"go"}}`,
+		},
+		{
+			name:  "code with unknown language",
+			block: notionapi.NewCode("brainfuck", "x"),
+			want:  `{"object":"block","type":"code","code":{"rich_text":[{"type":"text","text":{"content":"x"}}],"language":"plain text"}}`,
+		},
+		{
+			name:  "equation",
+			block: notionapi.NewEquation("e=mc^2"),
+			want:  `{"object":"block","type":"equation","equation":{"expression":"e=mc^2"}}`,
+		},
+		{
+			name:  "divider",
+			block: notionapi.NewDivider(),
+			want:  `{"object":"block","type":"divider","divider":{}}`,
+		},
+		{
+			name:  "breadcrumb",
+			block: notionapi.NewBreadcrumb(),
+			want:  `{"object":"block","type":"breadcrumb","breadcrumb":{}}`,
+		},
+		{
+			name:  "table of contents",
+			block: notionapi.NewTableOfContents(),
+			want:  `{"object":"block","type":"table_of_contents","table_of_contents":{}}`,
+		},
+		{
+			name:  "embed",
+			block: notionapi.NewEmbed("https://example.com"),
+			want:  `{"object":"block","type":"embed","embed":{"url":"https://example.com"}}`,
+		},
+		{
+			name:  "bookmark",
+			block: notionapi.NewBookmark("https://example.com"),
+			want:  `{"object":"block","type":"bookmark","bookmark":{"url":"https://example.com"}}`,
+		},
+		{
+			name:  "image",
+			block: notionapi.NewImage("https://example.com/a.png"),
+			want:  `{"object":"block","type":"image","image":{"type":"external","external":{"url":"https://example.com/a.png"}}}`,
+		},
+		{
+			name:  "video",
+			block: notionapi.NewVideo("https://example.com/a.mp4"),
+			want:  `{"object":"block","type":"video","video":{"type":"external","external":{"url":"https://example.com/a.mp4"}}}`,
+		},
+		{
+			name:  "audio",
+			block: notionapi.NewAudio("https://example.com/a.mp3"),
+			want:  `{"object":"block","type":"audio","audio":{"type":"external","external":{"url":"https://example.com/a.mp3"}}}`,
+		},
+		{
+			name:  "file",
+			block: notionapi.NewFile("https://example.com/a.zip"),
+			want:  `{"object":"block","type":"file","file":{"type":"external","external":{"url":"https://example.com/a.zip"}}}`,
+		},
+		{
+			name:  "pdf",
+			block: notionapi.NewPdf("https://example.com/a.pdf"),
+			want:  `{"object":"block","type":"pdf","pdf":{"type":"external","external":{"url":"https://example.com/a.pdf"}}}`,
+		},
+		{
+			name:  "link to page",
+			block: notionapi.NewLinkToPage("page_id"),
+			want:  `{"object":"block","type":"link_to_page","link_to_page":{"type":"page_id","page_id":"page_id"}}`,
+		},
+		{
+			name:  "link to database",
+			block: notionapi.NewLinkToDatabase("db_id"),
+			want:  `{"object":"block","type":"link_to_page","link_to_page":{"type":"database_id","database_id":"db_id"}}`,
+		},
+		{
+			name:  "table",
+			block: notionapi.NewTable([][]string{{"a", "b"}, {"c"}}, true),
+			want:  `{"object":"block","type":"table","table":{"table_width":2,"has_column_header":true,"has_row_header":false,"children":[{"object":"block","type":"table_row","table_row":{"cells":[[{"type":"text","text":{"content":"a"}}],[{"type":"text","text":{"content":"b"}}]]}},{"object":"block","type":"table_row","table_row":{"cells":[[{"type":"text","text":{"content":"c"}}],[]]}}]}}`,
+		},
+		{
+			name: "columns",
+			block: notionapi.NewColumns(
+				[]notionapi.Block{notionapi.NewParagraph(text("left"))},
+				[]notionapi.Block{notionapi.NewParagraph(text("right"))},
+			),
+			want: `{"object":"block","type":"column_list","column_list":{"children":[{"object":"block","type":"column","column":{"children":[{"object":"block","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"left"}}]}}]}},{"object":"block","type":"column","column":{"children":[{"object":"block","type":"paragraph","paragraph":{"rich_text":[{"type":"text","text":{"content":"right"}}]}}]}}]}}`,
+		},
+		{
+			name:  "empty column",
+			block: notionapi.NewColumn(),
+			want:  `{"object":"block","type":"column","column":{"children":[]}}`,
+		},
+		{
+			name:  "synced original",
+			block: notionapi.NewSyncedOriginal(notionapi.NewDivider()),
+			want:  `{"object":"block","type":"synced_block","synced_block":{"synced_from":null,"children":[{"object":"block","type":"divider","divider":{}}]}}`,
+		},
+		{
+			name:  "synced reference",
+			block: notionapi.NewSyncedReference("original_id"),
+			want:  `{"object":"block","type":"synced_block","synced_block":{"synced_from":{"type":"block_id","block_id":"original_id"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
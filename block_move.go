@@ -0,0 +1,99 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MoveBlockResult is returned by BlockClient.MoveBlock.
+type MoveBlockResult struct {
+	// Block is the copy of the moved block at its new position.
+	Block Block
+	// IDs maps the IDs of the moved block and its descendants to the IDs of
+	// their copies.
+	IDs map[ObjectID]ObjectID
+}
+
+// MoveBlock moves the block with the given ID and all of its children to
+// newParent, after the block after or at the end of newParent if after is
+// empty.
+//
+// The API cannot move blocks, so the block is recreated at the new position
+// and the original is archived. The copy gets new IDs, which are reported in
+// the result. If copying the block fails partway or archiving the original
+// fails, the copy is archived again so the content is not duplicated.
+// Blocks containing content that cannot be created through the API, like
+// child pages or files uploaded to Notion, are not moved, and neither is a
+// block moved into itself or one of its descendants.
+func (bc *BlockClient) MoveBlock(ctx context.Context, id BlockID, newParent BlockID, after BlockID) (*MoveBlockResult, error) {
+	b, err := bc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if b.GetHasChildren() && canHaveChildren(b) {
+		children, err := bc.GetTree(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		setBlockChildren(b, children)
+	}
+	if ids := uncreatableBlocks(Blocks{b}); len(ids) > 0 {
+		return nil, fmt.Errorf("cannot move block %s: blocks %v cannot be created through the API", id, ids)
+	}
+	if containsBlock(Blocks{b}, newParent) {
+		return nil, fmt.Errorf("cannot move block %s into itself or one of its descendants", id)
+	}
+
+	a := &treeAppender{client: bc, ids: map[ObjectID]ObjectID{}}
+	created, err := a.append(ctx, newParent, after, Blocks{b})
+	if err != nil {
+		// The copy of the moved block is recorded as soon as it exists and
+		// archiving it archives the copies of its children as well.
+		if copyID, ok := a.ids[ObjectID(id)]; ok {
+			if _, rollbackErr := bc.Delete(ctx, BlockID(copyID)); rollbackErr != nil {
+				return nil, fmt.Errorf("copying block %s failed and its partial copy %s could not be removed: %w", id, copyID, errors.Join(err, rollbackErr))
+			}
+			return nil, fmt.Errorf("copying block %s failed, its partial copy was removed: %w", id, err)
+		}
+		return nil, err
+	}
+	if len(created) != 1 {
+		return nil, fmt.Errorf("cannot move block %s: expected 1 created block, got %d", id, len(created))
+	}
+
+	if _, err := bc.Delete(ctx, id); err != nil {
+		if _, rollbackErr := bc.Delete(ctx, created[0].GetID()); rollbackErr != nil {
+			return nil, fmt.Errorf("archiving block %s failed and its copy %s could not be removed: %w", id, created[0].GetID(), errors.Join(err, rollbackErr))
+		}
+		return nil, fmt.Errorf("archiving block %s failed, its copy was removed: %w", id, err)
+	}
+	return &MoveBlockResult{Block: created[0], IDs: a.ids}, nil
+}
+
+// containsBlock reports whether the block with the given ID is part of the
+// tree.
+func containsBlock(blocks Blocks, id BlockID) bool {
+	for _, b := range blocks {
+		if b.GetID() == id || containsBlock(blockChildren(b), id) {
+			return true
+		}
+	}
+	return false
+}
+
+// uncreatableBlocks returns the IDs of the blocks in the tree that cannot be
+// created through the API.
+func uncreatableBlocks(blocks Blocks) []BlockID {
+	var ids []BlockID
+	for _, b := range blocks {
+		if !isCreatable(b) {
+			ids = append(ids, b.GetID())
+			continue
+		}
+		if !isSyncedReference(b) {
+			ids = append(ids, uncreatableBlocks(blockChildren(b))...)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,120 @@
+package notionapi_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestBlockClient_MoveBlock(t *testing.T) {
+	newFake := func() *fakeNotion {
+		f := newFakeNotion(t)
+		f.addBlocks("from", `[
+			{"id": "toggle", "type": "toggle", "toggle": {"rich_text": [{"type": "text", "text": {"content": "t"}}], "children": [
+				{"id": "inner", "type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "inner"}}], "children": [
+					{"id": "deep", "type": "divider", "divider": {}}
+				]}}
+			]}},
+			{"id": "stay", "type": "divider", "divider": {}}
+		]`)
+		f.addBlocks("to", `[
+			{"id": "first", "type": "divider", "divider": {}},
+			{"id": "last", "type": "divider", "divider": {}}
+		]`)
+		return f
+	}
+
+	t.Run("moves subtree after block", func(t *testing.T) {
+		f := newFake()
+		want := mustJSON(t, f.tree("from")[0])
+
+		got, err := f.client().Block.MoveBlock(context.Background(), "toggle", "to", "first")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(f.children["from"], []string{"stay"}) {
+			t.Errorf("source children = %v, want [stay]", f.children["from"])
+		}
+		to := f.children["to"]
+		if len(to) != 3 || to[0] != "first" || to[1] != string(got.Block.GetID()) || to[2] != "last" {
+			t.Errorf("target children = %v, want [first %s last]", to, got.Block.GetID())
+		}
+		if moved := mustJSON(t, f.tree("to")[1]); moved != want {
+			t.Errorf("moved tree = %s, want %s", moved, want)
+		}
+		for _, id := range []string{"toggle", "inner", "deep"} {
+			if _, ok := got.IDs[notionapi.ObjectID(id)]; !ok {
+				t.Errorf("MoveBlock() IDs has no entry for %s", id)
+			}
+		}
+	})
+
+	t.Run("rolls back when archiving fails", func(t *testing.T) {
+		f := newFake()
+		f.fail = func(request string) bool { return request == "DELETE blocks/toggle" }
+
+		_, err := f.client().Block.MoveBlock(context.Background(), "toggle", "to", "")
+		if err == nil || !strings.Contains(err.Error(), "copy was removed") {
+			t.Fatalf("MoveBlock() error = %v, want rollback error", err)
+		}
+		if !reflect.DeepEqual(f.children["to"], []string{"first", "last"}) {
+			t.Errorf("target children = %v, want [first last]", f.children["to"])
+		}
+		if len(f.children["from"]) != 2 {
+			t.Errorf("source children = %v, want the original blocks", f.children["from"])
+		}
+	})
+
+	t.Run("removes partial copy when copying fails", func(t *testing.T) {
+		f := newFake()
+		f.fail = func(request string) bool {
+			return strings.HasPrefix(request, "PATCH blocks/new-") && strings.HasSuffix(request, "/children")
+		}
+
+		_, err := f.client().Block.MoveBlock(context.Background(), "toggle", "to", "")
+		if err == nil || !strings.Contains(err.Error(), "partial copy was removed") {
+			t.Fatalf("MoveBlock() error = %v, want rollback error", err)
+		}
+		if last := f.requests[len(f.requests)-1]; last != "DELETE blocks/new-1" {
+			t.Errorf("last request = %s, want DELETE blocks/new-1", last)
+		}
+		if !reflect.DeepEqual(f.children["to"], []string{"first", "last"}) {
+			t.Errorf("target children = %v, want [first last]", f.children["to"])
+		}
+		if len(f.children["from"]) != 2 {
+			t.Errorf("source children = %v, want the original blocks", f.children["from"])
+		}
+	})
+
+	t.Run("refuses moving into the moved subtree", func(t *testing.T) {
+		for _, parent := range []notionapi.BlockID{"toggle", "inner", "deep"} {
+			f := newFake()
+			if _, err := f.client().Block.MoveBlock(context.Background(), "toggle", parent, ""); err == nil {
+				t.Errorf("MoveBlock() into %s error = nil, want error", parent)
+			}
+			for _, req := range f.requests {
+				if !strings.HasPrefix(req, "GET ") {
+					t.Errorf("MoveBlock() into %s sent %s", parent, req)
+				}
+			}
+		}
+	})
+
+	t.Run("refuses blocks that cannot be recreated", func(t *testing.T) {
+		f := newFake()
+		f.addBlocks("from", `[{"id": "sub", "type": "child_page", "child_page": {"title": "Sub"}}]`)
+
+		if _, err := f.client().Block.MoveBlock(context.Background(), "sub", "to", ""); err == nil {
+			t.Fatal("MoveBlock() error = nil, want error")
+		}
+		for _, req := range f.requests {
+			if !strings.HasPrefix(req, "GET ") {
+				t.Errorf("MoveBlock() sent %s", req)
+			}
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package notionapi
+
+import "strings"
+
+// OutlineEntry is a heading of a page, see Outline.
+type OutlineEntry struct {
+	// Level is 1, 2 or 3 for heading_1, heading_2 and heading_3 blocks.
+	Level   int
+	Text    string
+	BlockID BlockID
+	// URL links to the heading within the page.
+	URL string
+	// Children holds the headings of a lower level following this heading
+	// up to the next heading of the same or a higher level.
+	Children []*OutlineEntry
+}
+
+// Outline returns the headings of tree, the content of the page with the
+// given ID as returned by BlockClient.GetTree, nested by level. Headings
+// inside other blocks, like toggles, columns or toggleable headings, are
+// included in reading order. A heading without a preceding heading of a
+// higher level is placed at the top level.
+func Outline(page PageID, tree Blocks) []*OutlineEntry {
+	var result []*OutlineEntry
+	// stack holds the most recent entry of each open level.
+	var stack []*OutlineEntry
+	var walk func(blocks Blocks)
+	walk = func(blocks Blocks) {
+		for _, b := range blocks {
+			if level := headingLevel(b); level > 0 {
+				entry := &OutlineEntry{
+					Level:   level,
+					Text:    b.GetRichTextString(),
+					BlockID: b.GetID(),
+					URL:     BlockURL(page, b.GetID()),
+				}
+				for len(stack) > 0 && stack[len(stack)-1].Level >= level {
+					stack = stack[:len(stack)-1]
+				}
+				if len(stack) == 0 {
+					result = append(result, entry)
+				} else {
+					parent := stack[len(stack)-1]
+					parent.Children = append(parent.Children, entry)
+				}
+				stack = append(stack, entry)
+			}
+			walk(blockChildren(b))
+		}
+	}
+	walk(tree)
+	return result
+}
+
+// BlockURL returns the Notion link to the block with the given ID within the
+// page with the given ID.
+func BlockURL(page PageID, block BlockID) string {
+	return "https://www.notion.so/" + strings.ReplaceAll(page.String(), "-", "") +
+		"#" + strings.ReplaceAll(block.String(), "-", "")
+}
+
+// headingLevel returns the level of b if it is a heading, otherwise 0.
+func headingLevel(b Block) int {
+	switch b.(type) {
+	case *Heading1Block:
+		return 1
+	case *Heading2Block:
+		return 2
+	case *Heading3Block:
+		return 3
+	}
+	return 0
+}
@@ -0,0 +1,50 @@
+package notionapi_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestOutline(t *testing.T) {
+	heading := func(level int, id, title string, children ...notionapi.Block) notionapi.Block {
+		h := notionapi.Heading{RichText: []notionapi.RichText{{PlainText: title}}, Children: children}
+		basic := notionapi.BasicBlock{ID: notionapi.BlockID(id)}
+		switch level {
+		case 1:
+			return &notionapi.Heading1Block{BasicBlock: basic, Heading1: h}
+		case 2:
+			return &notionapi.Heading2Block{BasicBlock: basic, Heading2: h}
+		}
+		return &notionapi.Heading3Block{BasicBlock: basic, Heading3: h}
+	}
+	tree := notionapi.Blocks{
+		heading(2, "aaaa-1", "Preface"),
+		heading(1, "bbbb-2", "Intro"),
+		notionapi.NewParagraph(),
+		heading(3, "cccc-3", "Detail"),
+		heading(2, "dddd-4", "Toggle", heading(3, "eeee-5", "Hidden")),
+		notionapi.NewToggle("More", heading(2, "ffff-6", "Nested")),
+		heading(1, "0000-7", "End"),
+	}
+
+	url := func(id string) string {
+		return "https://www.notion.so/12345678#" + id
+	}
+	want := []*notionapi.OutlineEntry{
+		{Level: 2, Text: "Preface", BlockID: "aaaa-1", URL: url("aaaa1")},
+		{Level: 1, Text: "Intro", BlockID: "bbbb-2", URL: url("bbbb2"), Children: []*notionapi.OutlineEntry{
+			{Level: 3, Text: "Detail", BlockID: "cccc-3", URL: url("cccc3")},
+			{Level: 2, Text: "Toggle", BlockID: "dddd-4", URL: url("dddd4"), Children: []*notionapi.OutlineEntry{
+				{Level: 3, Text: "Hidden", BlockID: "eeee-5", URL: url("eeee5")},
+			}},
+			{Level: 2, Text: "Nested", BlockID: "ffff-6", URL: url("ffff6")},
+		}},
+		{Level: 1, Text: "End", BlockID: "0000-7", URL: url("00007")},
+	}
+	got := notionapi.Outline("1234-5678", tree)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Outline() = %s, want %s", mustJSON(t, got), mustJSON(t, want))
+	}
+}
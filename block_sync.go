@@ -0,0 +1,355 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type SyncOpType string
+
+const (
+	SyncOpUpdate SyncOpType = "update"
+	SyncOpDelete SyncOpType = "delete"
+	SyncOpAppend SyncOpType = "append"
+)
+
+// SyncOp is a single request of a SyncPlan.
+type SyncOp struct {
+	Type SyncOpType
+	// BlockID is the block to update or delete.
+	BlockID BlockID
+	// Parent is the block the blocks of an append are added to.
+	Parent BlockID
+	// After is the block an append inserts its blocks after. If empty, the
+	// blocks are added at the end of Parent.
+	After BlockID
+	// Blocks holds the desired block for an update and the blocks to add,
+	// with their children, for an append.
+	Blocks Blocks
+}
+
+func (op SyncOp) String() string {
+	switch op.Type {
+	case SyncOpUpdate:
+		return fmt.Sprintf("update %s (%s)", op.BlockID, op.Blocks[0].GetType())
+	case SyncOpDelete:
+		return fmt.Sprintf("delete %s", op.BlockID)
+	}
+	types := make([]string, len(op.Blocks))
+	for i, b := range op.Blocks {
+		types[i] = b.GetType().String()
+	}
+	position := "at the end"
+	if op.After != "" {
+		position = fmt.Sprintf("after %s", op.After)
+	}
+	return fmt.Sprintf("append %d block(s) to %s %s (%s)", len(op.Blocks), op.Parent, position, strings.Join(types, ", "))
+}
+
+// SyncPlan is the list of requests turning the current content of a block
+// into the desired content. See DiffBlocks.
+type SyncPlan struct {
+	Ops []SyncOp
+}
+
+// String returns one line per operation of the plan.
+func (p *SyncPlan) String() string {
+	lines := make([]string, len(p.Ops))
+	for i, op := range p.Ops {
+		lines[i] = op.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Apply executes the plan. Updates are sent first, then appends and then
+// deletes, so an append can insert after a block that is deleted afterwards.
+func (p *SyncPlan) Apply(ctx context.Context, blocks BlockService) error {
+	for _, opType := range []SyncOpType{SyncOpUpdate, SyncOpAppend, SyncOpDelete} {
+		for _, op := range p.Ops {
+			if op.Type != opType {
+				continue
+			}
+			var err error
+			switch op.Type {
+			case SyncOpDelete:
+				_, err = blocks.Delete(ctx, op.BlockID)
+			case SyncOpUpdate:
+				req, ok := updateRequestFor(op.Blocks[0])
+				if !ok {
+					return fmt.Errorf("%s: %w", op, ErrBlockNotUpdatable)
+				}
+				_, err = blocks.Update(ctx, op.BlockID, req)
+			case SyncOpAppend:
+				_, err = (&treeAppender{client: blocks}).append(ctx, op.Parent, op.After, op.Blocks)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+	return nil
+}
+
+// SyncOptions configures BlockClient.Sync.
+type SyncOptions struct {
+	// DryRun computes the plan and prints it to Output without changing
+	// anything.
+	DryRun bool
+	// Output receives the plan in dry-run mode. If nil, nothing is printed.
+	Output io.Writer
+}
+
+// Sync makes the content of the block or page with the given ID equal to
+// desired with as few requests as possible, keeping unchanged blocks and
+// their comments. It returns the executed plan.
+func (bc *BlockClient) Sync(ctx context.Context, id BlockID, desired Blocks, opts *SyncOptions) (*SyncPlan, error) {
+	if opts == nil {
+		opts = &SyncOptions{}
+	}
+	current, err := bc.GetTree(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := DiffBlocks(id, current, desired)
+	if opts.DryRun {
+		if opts.Output != nil && len(plan.Ops) > 0 {
+			if _, err := fmt.Fprintln(opts.Output, plan); err != nil {
+				return nil, err
+			}
+		}
+		return plan, nil
+	}
+	return plan, plan.Apply(ctx, bc)
+}
+
+// DiffBlocks compares current, the content of the block with the given ID as
+// returned by BlockClient.GetTree, with desired and returns the operations
+// turning current into desired.
+//
+// Blocks with equal content are kept and their children are compared
+// recursively. Between kept blocks, current blocks are updated in place when
+// a desired block of the same type takes their position, the remaining ones
+// are deleted and new blocks are appended after the preceding kept block.
+// Since the API cannot insert blocks before the first child of a block, new
+// blocks in front of all kept blocks are inserted after the first current
+// block, which is recreated behind them.
+//
+// Current blocks that cannot be created through the API, like child pages,
+// child databases or files uploaded to Notion, are never deleted, even if
+// desired omits them, so a sync cannot archive subpages or lose files.
+func DiffBlocks(id BlockID, current, desired Blocks) *SyncPlan {
+	plan := &SyncPlan{}
+	diffBlocks(plan, id, current, desired)
+	return plan
+}
+
+func diffBlocks(plan *SyncPlan, parent BlockID, current, desired Blocks) {
+	currentKeys := make([]string, len(current))
+	for i, b := range current {
+		currentKeys[i] = canonicalBlock(b)
+	}
+	desiredKeys := make([]string, len(desired))
+	for i, b := range desired {
+		desiredKeys[i] = canonicalBlock(b)
+	}
+
+	// matches[i] is the index of the current block desired[i] is based on,
+	// or -1 if desired[i] has to be created.
+	matches := make([]int, len(desired))
+	updates := make([]bool, len(desired))
+	for i := range matches {
+		matches[i] = -1
+	}
+	kept := longestCommonSubsequence(currentKeys, desiredKeys)
+	c, d := 0, 0
+	for _, pair := range append(kept, [2]int{len(current), len(desired)}) {
+		// Reuse current blocks of the gap for desired blocks of the same type.
+		next := c
+		for ; d < pair[1]; d++ {
+			for j := next; j < pair[0]; j++ {
+				if canUpdateInPlace(current[j], desired[d]) {
+					matches[d], updates[d] = j, true
+					next = j + 1
+					break
+				}
+			}
+		}
+		if pair[1] < len(desired) {
+			matches[pair[1]] = pair[0]
+		}
+		c, d = pair[0]+1, pair[1]+1
+	}
+
+	used := make([]bool, len(current))
+	var after BlockID
+	var pending Blocks
+	if len(desired) > 0 && matches[0] == -1 && hasMatch(matches) {
+		// Notion can only insert after an existing block, so new leading
+		// blocks are inserted after the first current block, which is then
+		// recreated behind them and deleted.
+		after = current[0].GetID()
+		if isCreatable(current[0]) {
+			for i, j := range matches {
+				if j == 0 {
+					matches[i], updates[i] = -1, false
+				}
+			}
+		}
+	}
+	flush := func() {
+		if len(pending) > 0 {
+			plan.Ops = append(plan.Ops, SyncOp{Type: SyncOpAppend, Parent: parent, After: after, Blocks: pending})
+			pending = nil
+		}
+	}
+	for i, b := range desired {
+		j := matches[i]
+		if j == -1 {
+			pending = append(pending, b)
+			continue
+		}
+		flush()
+		used[j] = true
+		after = current[j].GetID()
+		if updates[i] {
+			plan.Ops = append(plan.Ops, SyncOp{Type: SyncOpUpdate, BlockID: after, Blocks: Blocks{b}})
+		}
+		if canHaveChildren(current[j]) && !isSyncedReference(current[j]) {
+			diffBlocks(plan, after, blockChildren(current[j]), blockChildren(b))
+		}
+	}
+	flush()
+
+	for j, b := range current {
+		if !used[j] && isCreatable(b) {
+			plan.Ops = append(plan.Ops, SyncOp{Type: SyncOpDelete, BlockID: b.GetID()})
+		}
+	}
+}
+
+func hasMatch(matches []int) bool {
+	for _, j := range matches {
+		if j != -1 {
+			return true
+		}
+	}
+	return false
+}
+
+// longestCommonSubsequence returns the index pairs of a longest common
+// subsequence of a and b.
+func longestCommonSubsequence(a, b []string) [][2]int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var pairs [][2]int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			pairs = append(pairs, [2]int{i, j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
+
+// canonicalBlock returns a string identifying the content of b, without its
+// children. Read-only fields like IDs and plain_text, as well as default
+// values, are ignored, so a block built locally matches the same block
+// returned by the API.
+func canonicalBlock(b Block) string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return ""
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return ""
+	}
+	payload := raw[b.GetType().String()]
+	if p, ok := payload.(map[string]any); ok {
+		delete(p, "children")
+	}
+	canonical, _ := json.Marshal(canonicalValue(payload))
+	return b.GetType().String() + ":" + string(canonical)
+}
+
+func canonicalValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for k, value := range v {
+			if k == "plain_text" || k == "href" || (k == "color" && value == "default") {
+				continue
+			}
+			if value = canonicalValue(value); value != nil {
+				result[k] = value
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		result := make([]any, len(v))
+		for i, value := range v {
+			result[i] = canonicalValue(value)
+		}
+		return result
+	case bool:
+		if !v {
+			return nil
+		}
+	case string:
+		if v == "" {
+			return nil
+		}
+	}
+	return v
+}
+
+// canUpdateInPlace reports whether current can be turned into desired with
+// a single update.
+func canUpdateInPlace(current, desired Block) bool {
+	if current.GetType() != desired.GetType() {
+		return false
+	}
+	if _, ok := updateRequestFor(desired); !ok {
+		return false
+	}
+	switch c := current.(type) {
+	case *TableBlock:
+		// The width of a table cannot be changed.
+		return c.Table.TableWidth == desired.(*TableBlock).Table.TableWidth
+	case *SyncedBlock:
+		// A synced block cannot change between original and reference.
+		return isSyncedReference(c) == isSyncedReference(desired)
+	}
+	return true
+}
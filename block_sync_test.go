@@ -0,0 +1,266 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestDiffBlocks(t *testing.T) {
+	text := func(s string) notionapi.RichText {
+		return notionapi.RichText{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: s}}
+	}
+	withID := func(id notionapi.BlockID, b notionapi.Block) notionapi.Block {
+		switch b := b.(type) {
+		case *notionapi.ParagraphBlock:
+			b.ID = id
+		case *notionapi.Heading1Block:
+			b.ID = id
+		case *notionapi.BulletedListItemBlock:
+			b.ID = id
+		case *notionapi.DividerBlock:
+			b.ID = id
+		case *notionapi.TableBlock:
+			b.ID = id
+		case *notionapi.ImageBlock:
+			b.ID = id
+		}
+		return b
+	}
+	paragraph := func(s string, children ...notionapi.Block) *notionapi.ParagraphBlock {
+		p := notionapi.NewParagraph(text(s))
+		p.Paragraph.Children = children
+		return p
+	}
+	uploadedImage := func(caption string) *notionapi.ImageBlock {
+		return &notionapi.ImageBlock{
+			BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeImage},
+			Image: notionapi.Image{
+				Caption: []notionapi.RichText{text(caption)},
+				Type:    notionapi.FileTypeFile,
+				File:    &notionapi.FileObject{URL: "https://s3.example.com/a.png"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		current notionapi.Blocks
+		desired notionapi.Blocks
+		want    []string
+	}{
+		{
+			name: "unchanged",
+			current: notionapi.Blocks{
+				withID("h", notionapi.NewHeading(1, notionapi.RichText{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: "Title"}, PlainText: "Title", Annotations: &notionapi.Annotations{Color: notionapi.ColorDefault}})),
+				withID("a", paragraph("a")),
+			},
+			desired: notionapi.Blocks{notionapi.NewHeading(1, text("Title")), paragraph("a")},
+			want:    nil,
+		},
+		{
+			name: "update, append and delete",
+			current: notionapi.Blocks{
+				withID("a", paragraph("a")),
+				withID("b", paragraph("b", withID("x", notionapi.NewBulletedListItem(text("x"))))),
+				withID("div", notionapi.NewDivider()),
+				withID("c", paragraph("c")),
+			},
+			desired: notionapi.Blocks{
+				paragraph("a"),
+				paragraph("B", notionapi.NewBulletedListItem(text("x")), notionapi.NewBulletedListItem(text("y"))),
+				notionapi.NewQuote(text("new")),
+				paragraph("c"),
+			},
+			want: []string{
+				"update b (paragraph)",
+				"append 1 block(s) to b after x (bulleted_list_item)",
+				"append 1 block(s) to p after b (quote)",
+				"delete div",
+			},
+		},
+		{
+			name:    "leading insertion recreates the first block",
+			current: notionapi.Blocks{withID("a", paragraph("a"))},
+			desired: notionapi.Blocks{notionapi.NewDivider(), paragraph("a")},
+			want: []string{
+				"append 2 block(s) to p after a (divider, paragraph)",
+				"delete a",
+			},
+		},
+		{
+			name: "leading insertion keeps the following blocks",
+			current: notionapi.Blocks{
+				withID("a", paragraph("a")),
+				withID("b", paragraph("b")),
+				withID("c", paragraph("c")),
+				withID("d", paragraph("d")),
+			},
+			desired: notionapi.Blocks{notionapi.NewDivider(), paragraph("a"), paragraph("b"), paragraph("c"), paragraph("d")},
+			want: []string{
+				"append 2 block(s) to p after a (divider, paragraph)",
+				"delete a",
+			},
+		},
+		{
+			name:    "table width cannot be updated",
+			current: notionapi.Blocks{withID("t", notionapi.NewTable([][]string{{"a", "b"}}, false))},
+			desired: notionapi.Blocks{notionapi.NewTable([][]string{{"a", "b", "c"}}, false)},
+			want: []string{
+				"append 1 block(s) to p at the end (table)",
+				"delete t",
+			},
+		},
+		{
+			name:    "table headers are updated",
+			current: notionapi.Blocks{withID("t", notionapi.NewTable([][]string{{"a", "b"}}, false))},
+			desired: notionapi.Blocks{notionapi.NewTable([][]string{{"a", "b"}}, true)},
+			want:    []string{"update t (table)"},
+		},
+		{
+			name: "child pages and databases are kept",
+			current: notionapi.Blocks{
+				withID("a", paragraph("a")),
+				&notionapi.ChildPageBlock{BasicBlock: notionapi.BasicBlock{ID: "sub", Type: notionapi.BlockTypeChildPage}, ChildPage: notionapi.ChildPage{Title: "Sub"}},
+				&notionapi.ChildDatabaseBlock{BasicBlock: notionapi.BasicBlock{ID: "db", Type: notionapi.BlockTypeChildDatabase}},
+			},
+			desired: notionapi.Blocks{notionapi.NewDivider()},
+			want: []string{
+				"append 1 block(s) to p at the end (divider)",
+				"delete a",
+			},
+		},
+		{
+			name: "leading insertion keeps child pages",
+			current: notionapi.Blocks{
+				&notionapi.ChildPageBlock{BasicBlock: notionapi.BasicBlock{ID: "sub", Type: notionapi.BlockTypeChildPage}, ChildPage: notionapi.ChildPage{Title: "Sub"}},
+				withID("a", paragraph("a")),
+			},
+			desired: notionapi.Blocks{notionapi.NewDivider(), paragraph("a")},
+			want:    []string{"append 1 block(s) to p after sub (divider)"},
+		},
+		{
+			name: "uploaded files are not updated in place",
+			current: notionapi.Blocks{
+				withID("a", paragraph("a")),
+				withID("i", uploadedImage("old")),
+			},
+			desired: notionapi.Blocks{paragraph("a"), uploadedImage("new")},
+			want:    []string{"append 1 block(s) to p after a (image)"},
+		},
+		{
+			name:    "replace everything",
+			current: notionapi.Blocks{withID("a", paragraph("a"))},
+			desired: notionapi.Blocks{notionapi.NewDivider()},
+			want: []string{
+				"append 1 block(s) to p at the end (divider)",
+				"delete a",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := notionapi.DiffBlocks("p", tt.current, tt.desired).String()
+			if want := strings.Join(tt.want, "\n"); got != want {
+				t.Errorf("DiffBlocks() got =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestBlockClient_Sync(t *testing.T) {
+	text := func(s string) notionapi.RichText {
+		return notionapi.RichText{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: s}}
+	}
+	desired := notionapi.Blocks{
+		notionapi.NewParagraph(text("a")),
+		notionapi.NewToggle("more", notionapi.NewParagraph(text("inside"))),
+		notionapi.NewParagraph(text("changed")),
+	}
+
+	newFake := func() *fakeNotion {
+		f := newFakeNotion(t)
+		f.addBlocks("page", `[
+			{"id": "a", "type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "a"}}]}},
+			{"id": "b", "type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "b"}, "plain_text": "b"}]}}
+		]`)
+		return f
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		f := newFake()
+		var out bytes.Buffer
+		plan, err := f.client().Block.Sync(context.Background(), "page", desired, &notionapi.SyncOptions{DryRun: true, Output: &out})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "append 1 block(s) to page after a (toggle)\nupdate b (paragraph)\n"
+		if out.String() != want {
+			t.Errorf("Sync() printed %q, want %q", out.String(), want)
+		}
+		if len(plan.Ops) != 2 {
+			t.Errorf("Sync() plan has %d operations, want 2", len(plan.Ops))
+		}
+		for _, req := range f.requests {
+			if !strings.HasPrefix(req, "GET ") {
+				t.Errorf("Sync() sent %s in dry-run mode", req)
+			}
+		}
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		f := newFake()
+		if _, err := f.client().Block.Sync(context.Background(), "page", desired, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		want := newFakeNotion(t)
+		want.addBlocks("page", mustJSON(t, desired))
+		if got, want := mustJSON(t, f.tree("page")), mustJSON(t, want.tree("page")); got != want {
+			t.Errorf("synced tree = %s\nwant %s", got, want)
+		}
+		if f.children["page"][0] != "a" || f.children["page"][2] != "b" {
+			t.Errorf("Sync() did not keep blocks a and b: %v", f.children["page"])
+		}
+	})
+
+	t.Run("prepend", func(t *testing.T) {
+		f := newFake()
+		b := text("b")
+		b.PlainText = "b"
+		prepended := notionapi.Blocks{notionapi.NewDivider(), notionapi.NewParagraph(text("a")), notionapi.NewParagraph(b)}
+		if _, err := f.client().Block.Sync(context.Background(), "page", prepended, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		want := newFakeNotion(t)
+		want.addBlocks("page", mustJSON(t, prepended))
+		if got, want := mustJSON(t, f.tree("page")), mustJSON(t, want.tree("page")); got != want {
+			t.Errorf("synced tree = %s\nwant %s", got, want)
+		}
+		if f.children["page"][2] != "b" {
+			t.Errorf("Sync() did not keep block b: %v", f.children["page"])
+		}
+	})
+}
+
+func TestSyncPlanApplyRejectsNotUpdatableBlocks(t *testing.T) {
+	f := newFakeNotion(t)
+	plan := &notionapi.SyncPlan{Ops: []notionapi.SyncOp{{
+		Type:    notionapi.SyncOpUpdate,
+		BlockID: "sub",
+		Blocks:  notionapi.Blocks{&notionapi.ChildPageBlock{BasicBlock: notionapi.BasicBlock{ID: "sub", Type: notionapi.BlockTypeChildPage}}},
+	}}}
+	err := plan.Apply(context.Background(), f.client().Block)
+	if !errors.Is(err, notionapi.ErrBlockNotUpdatable) {
+		t.Errorf("Apply() error = %v, want ErrBlockNotUpdatable", err)
+	}
+	if len(f.requests) != 0 {
+		t.Errorf("Apply() sent %v", f.requests)
+	}
+}
@@ -0,0 +1,82 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetSyncedOriginal returns the original synced block of the synced block
+// with the given ID, with its whole content in SyncedBlock.Children. id can
+// be the original itself or any of its references.
+func (bc *BlockClient) GetSyncedOriginal(ctx context.Context, id BlockID) (*SyncedBlock, error) {
+	b, err := bc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	synced, ok := b.(*SyncedBlock)
+	if !ok {
+		return nil, fmt.Errorf("block %s is a %s block, not a synced block", id, b.GetType())
+	}
+
+	if synced.SyncedBlock.SyncedFrom != nil {
+		original := synced.SyncedBlock.SyncedFrom.BlockID
+		if b, err = bc.Get(ctx, original); err != nil {
+			return nil, err
+		}
+		if synced, ok = b.(*SyncedBlock); !ok {
+			return nil, fmt.Errorf("original block %s is a %s block, not a synced block", original, b.GetType())
+		}
+	}
+
+	children, err := bc.GetTree(ctx, synced.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	synced.SyncedBlock.Children = children
+	return synced, nil
+}
+
+// CreateSyncedBlock creates an original synced block holding children in
+// parent, after the block after or at the end of parent if after is empty.
+func (bc *BlockClient) CreateSyncedBlock(ctx context.Context, parent BlockID, after BlockID, children Blocks) (*SyncedBlock, error) {
+	return bc.appendSynced(ctx, parent, after, NewSyncedOriginal(children...))
+}
+
+// InsertSyncedReference creates a reference to the original synced block in
+// parent, after the block after or at the end of parent if after is empty.
+func (bc *BlockClient) InsertSyncedReference(ctx context.Context, original BlockID, parent BlockID, after BlockID) (*SyncedBlock, error) {
+	return bc.appendSynced(ctx, parent, after, NewSyncedReference(original))
+}
+
+func (bc *BlockClient) appendSynced(ctx context.Context, parent BlockID, after BlockID, b *SyncedBlock) (*SyncedBlock, error) {
+	created, err := bc.AppendTree(ctx, parent, after, Blocks{b})
+	if err != nil {
+		return nil, err
+	}
+	if len(created) != 1 {
+		return nil, fmt.Errorf("expected 1 created block, got %d", len(created))
+	}
+	synced, ok := created[0].(*SyncedBlock)
+	if !ok {
+		return nil, fmt.Errorf("created block is a %s block, not a synced block", created[0].GetType())
+	}
+	return synced, nil
+}
+
+// FindSyncedReferences returns the references to the original synced block
+// found in tree, e.g. the result of GetTree, including nested ones. The API
+// offers no way to list all references in a workspace, so only the given
+// tree is searched.
+func FindSyncedReferences(tree Blocks, original BlockID) []*SyncedBlock {
+	var refs []*SyncedBlock
+	for _, b := range tree {
+		if synced, ok := b.(*SyncedBlock); ok && synced.SyncedBlock.SyncedFrom != nil {
+			if synced.SyncedBlock.SyncedFrom.BlockID == original {
+				refs = append(refs, synced)
+			}
+			continue
+		}
+		refs = append(refs, FindSyncedReferences(blockChildren(b), original)...)
+	}
+	return refs
+}
@@ -0,0 +1,83 @@
+package notionapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestSyncedBlocks(t *testing.T) {
+	newFake := func() *fakeNotion {
+		f := newFakeNotion(t)
+		f.addBlocks("page", `[
+			{"id": "orig", "type": "synced_block", "synced_block": {"synced_from": null, "children": [
+				{"id": "shared", "type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "shared"}}]}}
+			]}},
+			{"id": "x", "type": "toggle", "toggle": {"rich_text": [], "children": [
+				{"id": "ref", "type": "synced_block", "synced_block": {"synced_from": {"type": "block_id", "block_id": "orig"}}}
+			]}}
+		]`)
+		return f
+	}
+
+	t.Run("get original from reference", func(t *testing.T) {
+		f := newFake()
+		got, err := f.client().Block.GetSyncedOriginal(context.Background(), "ref")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.ID != "orig" || len(got.SyncedBlock.Children) != 1 || got.SyncedBlock.Children[0].GetID() != "shared" {
+			t.Errorf("GetSyncedOriginal() = %s with children %v", got.ID, got.SyncedBlock.Children)
+		}
+	})
+
+	t.Run("find references", func(t *testing.T) {
+		f := newFake()
+		tree, err := f.client().Block.GetTree(context.Background(), "page", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		refs := notionapi.FindSyncedReferences(tree, "orig")
+		if len(refs) != 1 || refs[0].ID != "ref" {
+			t.Errorf("FindSyncedReferences() = %v, want [ref]", refs)
+		}
+	})
+
+	t.Run("inline references", func(t *testing.T) {
+		f := newFake()
+		tree, err := f.client().Block.GetTree(context.Background(), "page", &notionapi.TreeOptions{InlineSyncedBlocks: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		children := tree[1].(*notionapi.ToggleBlock).Toggle.Children
+		if len(children) != 1 || children[0].GetID() != "shared" {
+			t.Errorf("inlined children = %v, want [shared]", children)
+		}
+		if _, ok := tree[0].(*notionapi.SyncedBlock); !ok {
+			t.Errorf("original synced block was inlined")
+		}
+	})
+
+	t.Run("create original and reference", func(t *testing.T) {
+		f := newFakeNotion(t)
+		client := f.client()
+		original, err := client.Block.CreateSyncedBlock(context.Background(), "page", "", notionapi.Blocks{
+			notionapi.NewToggle("t", notionapi.NewDivider()),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ref, err := client.Block.InsertSyncedReference(context.Background(), original.ID, "other", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := mustJSON(t, f.tree(string(original.ID))); got != `[{"toggle":{"children":[{"divider":{},"type":"divider"}],"rich_text":[{"text":{"content":"t"},"type":"text"}]},"type":"toggle"}]` {
+			t.Errorf("original content = %s", got)
+		}
+		if ref.SyncedBlock.SyncedFrom == nil || ref.SyncedBlock.SyncedFrom.BlockID != original.ID {
+			t.Errorf("reference synced from = %+v, want %s", ref.SyncedBlock.SyncedFrom, original.ID)
+		}
+	})
+}
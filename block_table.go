@@ -0,0 +1,235 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// TableView is a table block together with its rows, as a grid of cells.
+// Rows holds every row of the table including the header row, if the table
+// has one.
+type TableView struct {
+	BlockID         BlockID
+	Width           int
+	HasColumnHeader bool
+	HasRowHeader    bool
+	// RowIDs holds the IDs of the table_row blocks, in the order of Rows.
+	RowIDs []BlockID
+	Rows   [][][]RichText
+}
+
+// NewTableView returns the view of a table block whose rows are stored in
+// Table.Children, like the tables returned by BlockClient.GetTree.
+func NewTableView(b *TableBlock) *TableView {
+	t := &TableView{
+		BlockID:         b.ID,
+		Width:           b.Table.TableWidth,
+		HasColumnHeader: b.Table.HasColumnHeader,
+		HasRowHeader:    b.Table.HasRowHeader,
+	}
+	for _, child := range b.Table.Children {
+		if row, ok := child.(*TableRowBlock); ok {
+			t.RowIDs = append(t.RowIDs, row.ID)
+			t.Rows = append(t.Rows, row.TableRow.Cells)
+		}
+	}
+	return t
+}
+
+// Headers returns the header row, or nil if the table has no column header.
+func (t *TableView) Headers() [][]RichText {
+	if !t.HasColumnHeader || len(t.Rows) == 0 {
+		return nil
+	}
+	return t.Rows[0]
+}
+
+// Body returns the rows below the header row.
+func (t *TableView) Body() [][][]RichText {
+	if t.HasColumnHeader && len(t.Rows) > 0 {
+		return t.Rows[1:]
+	}
+	return t.Rows
+}
+
+// Strings returns the plain text of every cell of the table. The cells are
+// left unchanged.
+func (t *TableView) Strings() [][]string {
+	result := make([][]string, len(t.Rows))
+	for i, row := range t.Rows {
+		result[i] = make([]string, t.Width)
+		for j, cell := range row {
+			if j < t.Width {
+				// PlainText fills in missing plain text, so it works on a copy.
+				result[i][j] = PlainText(append([]RichText(nil), cell...))
+			}
+		}
+	}
+	return result
+}
+
+// WriteCSV writes the plain text of the table, including the header row, to
+// w as CSV.
+func (t *TableView) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(t.Strings()); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// TableFromCSV reads CSV records from r and returns a table block holding
+// them. If header is true, the first record is used as the column header.
+func TableFromCSV(r io.Reader, header bool) (*TableBlock, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return NewTable(records, header), nil
+}
+
+// ReadTable returns the table block with the given ID and all of its rows.
+func (bc *BlockClient) ReadTable(ctx context.Context, id BlockID) (*TableView, error) {
+	b, err := bc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	table, ok := b.(*TableBlock)
+	if !ok {
+		return nil, fmt.Errorf("block %s is a %s block, not a table", id, b.GetType())
+	}
+	if table.Table.Children, err = getAllChildren(ctx, bc, id); err != nil {
+		return nil, err
+	}
+	return NewTableView(table), nil
+}
+
+// WriteTable creates a table holding rows in parent, after the block after
+// or at the end of parent if after is empty. See NewTable for how rows and
+// header are used.
+func (bc *BlockClient) WriteTable(ctx context.Context, parent BlockID, after BlockID, rows [][]string, header bool) (*TableView, error) {
+	created, err := bc.AppendTree(ctx, parent, after, Blocks{NewTable(rows, header)})
+	if err != nil {
+		return nil, err
+	}
+	if len(created) != 1 {
+		return nil, fmt.Errorf("expected 1 created block, got %d", len(created))
+	}
+	return bc.ReadTable(ctx, created[0].GetID())
+}
+
+// UpdateCell sets the cell of the table at the given row and column, both
+// starting at 0 with the header row counted as a row, to value.
+func (bc *BlockClient) UpdateCell(ctx context.Context, table *TableView, row, column int, value string) error {
+	if row < 0 || row >= len(table.Rows) || column < 0 || column >= table.Width {
+		return fmt.Errorf("cell %d,%d is outside of the %dx%d table", row, column, len(table.Rows), table.Width)
+	}
+	cells := table.padRow(table.Rows[row])
+	cells[column] = textRichText(value)
+
+	if _, err := bc.Update(ctx, table.RowIDs[row], &BlockUpdateRequest{TableRow: &TableRow{Cells: cells}}); err != nil {
+		return err
+	}
+	table.Rows[row] = cells
+	return nil
+}
+
+// AppendRow adds a row holding cells at the end of the table. Rows shorter
+// than the table are padded with empty cells; the width of a table cannot be
+// changed, so longer rows are rejected.
+func (bc *BlockClient) AppendRow(ctx context.Context, table *TableView, cells ...string) error {
+	return bc.appendRows(ctx, table, [][]string{cells})
+}
+
+// ReplaceRows replaces the content of the table with rows, the header row
+// included. Existing rows are updated in place, missing rows are appended
+// and surplus rows are deleted.
+func (bc *BlockClient) ReplaceRows(ctx context.Context, table *TableView, rows [][]string) error {
+	newRows := make([]*TableRowBlock, len(rows))
+	for i, row := range rows {
+		r, err := table.newRow(row)
+		if err != nil {
+			return err
+		}
+		newRows[i] = r
+	}
+
+	for i := 0; i < len(rows) && i < len(table.Rows); i++ {
+		cells := newRows[i].TableRow.Cells
+		if _, err := bc.Update(ctx, table.RowIDs[i], &BlockUpdateRequest{TableRow: &TableRow{Cells: cells}}); err != nil {
+			return err
+		}
+		table.Rows[i] = cells
+	}
+	for len(table.Rows) > len(rows) {
+		last := len(table.Rows) - 1
+		if _, err := bc.Delete(ctx, table.RowIDs[last]); err != nil {
+			return err
+		}
+		table.Rows, table.RowIDs = table.Rows[:last], table.RowIDs[:last]
+	}
+	if len(rows) > len(table.Rows) {
+		return bc.appendRows(ctx, table, rows[len(table.Rows):])
+	}
+	return nil
+}
+
+func (bc *BlockClient) appendRows(ctx context.Context, table *TableView, rows [][]string) error {
+	blocks := make(Blocks, len(rows))
+	for i, row := range rows {
+		r, err := table.newRow(row)
+		if err != nil {
+			return err
+		}
+		blocks[i] = r
+	}
+
+	for _, chunk := range chunkBlocks(blocks) {
+		res, err := bc.AppendChildren(ctx, table.BlockID, &AppendBlockChildrenRequest{Children: chunk})
+		if err != nil {
+			return err
+		}
+		if len(res.Results) != len(chunk) {
+			return fmt.Errorf("appended %d rows to table %s but got %d blocks back", len(chunk), table.BlockID, len(res.Results))
+		}
+		for i, row := range chunk {
+			table.RowIDs = append(table.RowIDs, res.Results[i].GetID())
+			table.Rows = append(table.Rows, row.(*TableRowBlock).TableRow.Cells)
+		}
+	}
+	return nil
+}
+
+// newRow returns a table row holding cells, padded to the width of the
+// table.
+func (t *TableView) newRow(cells []string) (*TableRowBlock, error) {
+	if len(cells) > t.Width {
+		return nil, fmt.Errorf("row has %d cells but the table is %d cells wide", len(cells), t.Width)
+	}
+	row := make([][]RichText, t.Width)
+	for i := range row {
+		if i < len(cells) && cells[i] != "" {
+			row[i] = textRichText(cells[i])
+		} else {
+			row[i] = []RichText{}
+		}
+	}
+	return NewTableRow(row...), nil
+}
+
+// padRow returns a copy of cells with exactly as many cells as the table is
+// wide.
+func (t *TableView) padRow(cells [][]RichText) [][]RichText {
+	row := make([][]RichText, t.Width)
+	copy(row, cells)
+	for i := range row {
+		if row[i] == nil {
+			row[i] = []RichText{}
+		}
+	}
+	return row
+}
@@ -0,0 +1,134 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestTableView(t *testing.T) {
+	t.Run("write and read large table", func(t *testing.T) {
+		f := newFakeNotion(t)
+		client := f.client()
+
+		rows := [][]string{{"Name", "Value"}}
+		for i := 0; i < 150; i++ {
+			rows = append(rows, []string{fmt.Sprintf("row %d", i), fmt.Sprint(i)})
+		}
+		written, err := client.Block.WriteTable(context.Background(), "page", "", rows, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := client.Block.ReadTable(context.Background(), written.BlockID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got.Strings(), rows) {
+			t.Errorf("ReadTable() rows differ from written rows")
+		}
+		if h := got.Headers(); len(h) != 2 || notionapi.PlainText(h[0]) != "Name" {
+			t.Errorf("Headers() = %v", h)
+		}
+		if n := len(got.Body()); n != 150 {
+			t.Errorf("Body() has %d rows, want 150", n)
+		}
+	})
+
+	t.Run("strings leaves cells unchanged", func(t *testing.T) {
+		cell := []notionapi.RichText{{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: "a"}}}
+		table := &notionapi.TableView{Width: 1, Rows: [][][]notionapi.RichText{{cell}}}
+		if got := table.Strings(); !reflect.DeepEqual(got, [][]string{{"a"}}) {
+			t.Errorf("Strings() = %v", got)
+		}
+		if cell[0].PlainText != "" {
+			t.Errorf("Strings() set the plain text of the cell to %q", cell[0].PlainText)
+		}
+	})
+
+	t.Run("edit table", func(t *testing.T) {
+		f := newFakeNotion(t)
+		client := f.client()
+		table, err := client.Block.WriteTable(context.Background(), "page", "", [][]string{{"a", "b"}, {"c", "d"}, {"e", "f"}}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := client.Block.UpdateCell(context.Background(), table, 1, 1, "D"); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Block.AppendRow(context.Background(), table, "g"); err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Block.AppendRow(context.Background(), table, "x", "y", "z"); err == nil {
+			t.Error("AppendRow() accepted a row wider than the table")
+		}
+		if err := client.Block.UpdateCell(context.Background(), table, 9, 0, "x"); err == nil {
+			t.Error("UpdateCell() accepted a cell outside of the table")
+		}
+
+		want := [][]string{{"a", "b"}, {"c", "D"}, {"e", "f"}, {"g", ""}}
+		got, err := client.Block.ReadTable(context.Background(), table.BlockID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got.Strings(), want) || !reflect.DeepEqual(table.Strings(), want) {
+			t.Errorf("table = %v, view = %v, want %v", got.Strings(), table.Strings(), want)
+		}
+
+		want = [][]string{{"1", "2"}, {"3", "4"}}
+		if err := client.Block.ReplaceRows(context.Background(), table, want); err != nil {
+			t.Fatal(err)
+		}
+		if got, err = client.Block.ReadTable(context.Background(), table.BlockID); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got.Strings(), want) || !reflect.DeepEqual(got.RowIDs, table.RowIDs) {
+			t.Errorf("ReplaceRows() table = %v %v, view = %v", got.Strings(), got.RowIDs, table.RowIDs)
+		}
+	})
+
+	t.Run("append with short response", func(t *testing.T) {
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newTestClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"object":"list","results":[],"has_more":false}`)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}
+		})))
+		table := &notionapi.TableView{BlockID: "table", Width: 1}
+
+		if err := client.Block.AppendRow(context.Background(), table, "a"); err == nil {
+			t.Error("AppendRow() error = nil, want error")
+		}
+		if len(table.Rows) != 0 || len(table.RowIDs) != 0 {
+			t.Errorf("AppendRow() recorded rows %v %v", table.Rows, table.RowIDs)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		in := "Name,Notes\nAda,\"first, programmer\"\nAlan\n"
+		block, err := notionapi.TableFromCSV(strings.NewReader(in), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if block.Table.TableWidth != 2 || !block.Table.HasColumnHeader || len(block.Table.Children) != 3 {
+			t.Fatalf("TableFromCSV() = %+v", block.Table)
+		}
+
+		var out bytes.Buffer
+		if err := notionapi.NewTableView(block).WriteCSV(&out); err != nil {
+			t.Fatal(err)
+		}
+		if want := "Name,Notes\nAda,\"first, programmer\"\nAlan,\n"; out.String() != want {
+			t.Errorf("WriteCSV() = %q, want %q", out.String(), want)
+		}
+	})
+}
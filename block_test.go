@@ -223,6 +223,18 @@ func TestBlockClient(t *testing.T) {
 	})
 
 	t.Run("Update", func(t *testing.T) {
+		basic := func(blockType notionapi.BlockType, hasChildren bool) notionapi.BasicBlock {
+			return notionapi.BasicBlock{
+				Object:         notionapi.ObjectTypeBlock,
+				ID:             "some_id",
+				Type:           blockType,
+				CreatedTime:    &timestamp,
+				LastEditedTime: &timestamp,
+				HasChildren:    hasChildren,
+			}
+		}
+		archived := false
+
 		tests := []struct {
 			name       string
 			filePath   string
@@ -273,6 +285,102 @@ func TestBlockClient(t *testing.T) {
 				wantErr: false,
 				err:     nil,
 			},
+			{
+				name:       "updates table headers",
+				filePath:   "testdata/block_update_table.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req: &notionapi.BlockUpdateRequest{
+					Table: &notionapi.TableUpdate{HasColumnHeader: true, HasRowHeader: true},
+				},
+				want: &notionapi.TableBlock{
+					BasicBlock: basic(notionapi.BlockTypeTableBlock, true),
+					Table:      notionapi.Table{TableWidth: 2, HasColumnHeader: true, HasRowHeader: true},
+				},
+			},
+			{
+				name:       "updates divider",
+				filePath:   "testdata/block_update_divider.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req:        &notionapi.BlockUpdateRequest{Divider: &notionapi.Divider{}},
+				want:       &notionapi.DividerBlock{BasicBlock: basic(notionapi.BlockTypeDivider, false)},
+			},
+			{
+				name:       "updates audio",
+				filePath:   "testdata/block_update_audio.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req: &notionapi.BlockUpdateRequest{
+					Audio: &notionapi.Audio{Type: notionapi.FileTypeExternal, External: &notionapi.FileObject{URL: "https://example.com/new.mp3"}},
+				},
+				want: &notionapi.AudioBlock{
+					BasicBlock: basic(notionapi.BlockTypeAudio, false),
+					Audio: notionapi.Audio{
+						Caption:  []notionapi.RichText{},
+						Type:     notionapi.FileTypeExternal,
+						External: &notionapi.FileObject{URL: "https://example.com/new.mp3"},
+					},
+				},
+			},
+			{
+				name:       "updates breadcrumb",
+				filePath:   "testdata/block_update_breadcrumb.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req:        &notionapi.BlockUpdateRequest{Breadcrumb: &notionapi.Breadcrumb{}},
+				want:       &notionapi.BreadcrumbBlock{BasicBlock: basic(notionapi.BlockTypeBreadcrumb, false)},
+			},
+			{
+				name:       "updates table of contents color",
+				filePath:   "testdata/block_update_table_of_contents.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req: &notionapi.BlockUpdateRequest{
+					TableOfContents: &notionapi.TableOfContents{Color: notionapi.ColorGray.String()},
+				},
+				want: &notionapi.TableOfContentsBlock{
+					BasicBlock:      basic(notionapi.BlockTypeTableOfContents, false),
+					TableOfContents: notionapi.TableOfContents{Color: notionapi.ColorGray.String()},
+				},
+			},
+			{
+				name:       "updates link to page",
+				filePath:   "testdata/block_update_link_to_page.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req: &notionapi.BlockUpdateRequest{
+					LinkToPage: &notionapi.LinkToPage{Type: "page_id", PageID: "other_page"},
+				},
+				want: &notionapi.LinkToPageBlock{
+					BasicBlock: basic(notionapi.BlockTypeLinkToPage, false),
+					LinkToPage: notionapi.LinkToPage{Type: "page_id", PageID: "other_page"},
+				},
+			},
+			{
+				name:       "updates synced block",
+				filePath:   "testdata/block_update_synced_block.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req:        &notionapi.BlockUpdateRequest{SyncedBlock: &notionapi.Synced{}},
+				want:       &notionapi.SyncedBlock{BasicBlock: basic(notionapi.BlockTypeSyncedBlock, true)},
+			},
+			{
+				name:       "updates column list",
+				filePath:   "testdata/block_update_column_list.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req:        &notionapi.BlockUpdateRequest{ColumnList: &notionapi.ColumnListUpdate{}},
+				want:       &notionapi.ColumnListBlock{BasicBlock: basic(notionapi.BlockTypeColumnList, true)},
+			},
+			{
+				name:       "restores archived block",
+				filePath:   "testdata/block_update_restore.json",
+				statusCode: http.StatusOK,
+				id:         "some_id",
+				req:        &notionapi.BlockUpdateRequest{Archived: &archived},
+				want:       &notionapi.DividerBlock{BasicBlock: basic(notionapi.BlockTypeDivider, false)},
+			},
 		}
 
 		for _, tt := range tests {
@@ -501,6 +609,44 @@ func TestBlockUpdateRequest_MarshallJSON(t *testing.T) {
 			},
 			want: []byte(`{"to_do":{"rich_text":[],"checked":false}}`),
 		},
+		{
+			name: "update table headers without width",
+			req: &notionapi.BlockUpdateRequest{
+				Table: &notionapi.TableUpdate{HasColumnHeader: true},
+			},
+			want: []byte(`{"table":{"has_column_header":true,"has_row_header":false}}`),
+		},
+		{
+			name: "update empty blocks",
+			req: &notionapi.BlockUpdateRequest{
+				Divider:    &notionapi.Divider{},
+				Breadcrumb: &notionapi.Breadcrumb{},
+				ColumnList: &notionapi.ColumnListUpdate{},
+			},
+			want: []byte(`{"divider":{},"breadcrumb":{},"column_list":{}}`),
+		},
+		{
+			name: "update table of contents and link to page",
+			req: &notionapi.BlockUpdateRequest{
+				TableOfContents: &notionapi.TableOfContents{Color: "red"},
+				LinkToPage:      &notionapi.LinkToPage{Type: "database_id", DatabaseID: "db"},
+			},
+			want: []byte(`{"table_of_contents":{"color":"red"},"link_to_page":{"type":"database_id","database_id":"db"}}`),
+		},
+		{
+			name: "update audio",
+			req: &notionapi.BlockUpdateRequest{
+				Audio: &notionapi.Audio{Type: notionapi.FileTypeExternal, External: &notionapi.FileObject{URL: "https://example.com/a.mp3"}},
+			},
+			want: []byte(`{"audio":{"type":"external","external":{"url":"https://example.com/a.mp3"}}}`),
+		},
+		{
+			name: "restore block",
+			req: &notionapi.BlockUpdateRequest{
+				Archived: ptr(false),
+			},
+			want: []byte(`{"archived":false}`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -516,3 +662,129 @@ func TestBlockUpdateRequest_MarshallJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockArrayUnmarshal_AllTypes(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/block_array_all_types.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blocks notionapi.Blocks
+	if err := json.Unmarshal(data, &blocks); err != nil {
+		t.Fatal(err)
+	}
+
+	wantTypes := []string{
+		"*notionapi.AudioBlock",
+		"*notionapi.VideoBlock",
+		"*notionapi.Heading2Block",
+		"*notionapi.ChildPageBlock",
+		"*notionapi.ParagraphBlock",
+		"*notionapi.UnknownBlock",
+		"*notionapi.UnsupportedBlock",
+	}
+	if len(blocks) != len(wantTypes) {
+		t.Fatalf("got %d blocks, want %d", len(blocks), len(wantTypes))
+	}
+	for i, b := range blocks {
+		if got := reflect.TypeOf(b).String(); got != wantTypes[i] {
+			t.Errorf("block %d: got %s, want %s", i, got, wantTypes[i])
+		}
+	}
+
+	if got := blocks[0].(notionapi.DownloadableFileBlock).GetURL(); got != "https://example.com/song.mp3" {
+		t.Errorf("audio URL = %q", got)
+	}
+	if !blocks[2].(*notionapi.Heading2Block).Heading2.IsToggleable {
+		t.Errorf("heading is not toggleable")
+	}
+	if got := blocks[3].(*notionapi.ChildPageBlock).ChildPage.Title; got != "Sub page" {
+		t.Errorf("child page title = %q", got)
+	}
+	mention := blocks[4].(*notionapi.ParagraphBlock).Paragraph.RichText[0].Mention
+	if mention.Type != notionapi.MentionTypeLinkMention || mention.LinkMention.LinkProvider != "GitHub" {
+		t.Errorf("link mention = %+v", mention)
+	}
+
+	unknown := blocks[5].(*notionapi.UnknownBlock)
+	if unknown.ID != "unknown_id" || unknown.Type != "some_future_block" {
+		t.Errorf("unknown block basic fields = %+v", unknown.BasicBlock)
+	}
+	got, err := json.Marshal(unknown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTrip map[string]any
+	if err := json.Unmarshal(got, &roundTrip); err != nil {
+		t.Fatal(err)
+	}
+	if roundTrip["some_future_block"].(map[string]any)["answer"] != float64(42) {
+		t.Errorf("unknown block lost its content: %s", got)
+	}
+}
+
+func TestBlockUnknownFieldsSkipReadOnly(t *testing.T) {
+	raw := `[{"object":"block","id":"some_id","type":"divider","in_trash":false,"request_id":"abc","future_field":1,"divider":{}}]`
+
+	var blocks notionapi.Blocks
+	if err := json.Unmarshal([]byte(raw), &blocks); err != nil {
+		t.Fatal(err)
+	}
+	blocks[0].(*notionapi.DividerBlock).ID = ""
+
+	got, err := json.Marshal(notionapi.AppendBlockChildrenRequest{Children: blocks})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"children":[{"divider":{},"future_field":1,"object":"block","type":"divider"}]}`
+	if string(got) != want {
+		t.Errorf("Marshal() got = %s, want %s", got, want)
+	}
+}
+
+func TestBlockUnknownFieldsRoundTrip(t *testing.T) {
+	raw := `[{"object":"block","id":"some_id","type":"paragraph","future_field":{"a":1},"paragraph":{"rich_text":[{"type":"text","text":{"content":"old"}}],"color":"default","future_option":true}}]`
+
+	var blocks notionapi.Blocks
+	if err := json.Unmarshal([]byte(raw), &blocks); err != nil {
+		t.Fatal(err)
+	}
+	p := blocks[0].(*notionapi.ParagraphBlock)
+	p.Paragraph.RichText[0].Text.Content = "new"
+	p.ID = ""
+
+	got, err := json.Marshal(blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"future_field":{"a":1},"object":"block","paragraph":{"color":"default","future_option":true,"rich_text":[{"type":"text","text":{"content":"new"}}]},"type":"paragraph"}]`
+	if string(got) != want {
+		t.Errorf("Marshal() got = %s, want %s", got, want)
+	}
+}
+
+func TestAppendChildrenFetchedBlocks(t *testing.T) {
+	raw := `[{"object":"block","id":"toggle_id","parent":{"type":"page_id","page_id":"page_id"},
+		"created_time":"2021-05-24T05:06:34.827Z","last_edited_time":"2021-05-24T05:06:34.827Z",
+		"created_by":{"object":"user","id":"user_id"},"has_children":true,"archived":false,"type":"toggle",
+		"toggle":{"rich_text":[{"type":"text","text":{"content":"t"}}],"children":[
+			{"object":"block","id":"child_id","created_time":"2021-05-24T05:06:34.827Z","has_children":false,"type":"divider","divider":{}}
+		]}}]`
+	var blocks notionapi.Blocks
+	if err := json.Unmarshal([]byte(raw), &blocks); err != nil {
+		t.Fatal(err)
+	}
+
+	var body string
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newRecordingClient(t, "testdata/block_append_children.json", &body)))
+	if _, err := client.Block.AppendChildren(context.Background(), "some_id", &notionapi.AppendBlockChildrenRequest{Children: blocks}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"children":[{"object":"block","type":"toggle","toggle":{"rich_text":[{"type":"text","text":{"content":"t"}}],"children":[{"object":"block","type":"divider","divider":{}}]}}]}`
+	if body != want {
+		t.Errorf("request body = %s, want %s", body, want)
+	}
+	if blocks[0].GetID() != "toggle_id" {
+		t.Errorf("AppendChildren() changed the sent blocks")
+	}
+}
@@ -0,0 +1,26 @@
+package notionapi
+
+import "strings"
+
+// ExtractText returns the plain text of blocks, usually the result of
+// BlockClient.GetTree, in reading order with one line per block. Nested
+// children follow their parent. Headings are prefixed with "#", "##" or
+// "###" according to their level, so the structure of the page survives in
+// the text. Blocks without text are left out.
+func ExtractText(blocks Blocks) string {
+	var lines []string
+	extractText(&lines, blocks)
+	return strings.Join(lines, "\n")
+}
+
+func extractText(lines *[]string, blocks Blocks) {
+	for _, b := range blocks {
+		if text := b.GetRichTextString(); text != "" {
+			if level := headingLevel(b); level > 0 {
+				text = strings.Repeat("#", level) + " " + text
+			}
+			*lines = append(*lines, text)
+		}
+		extractText(lines, blockChildren(b))
+	}
+}
@@ -0,0 +1,58 @@
+package notionapi_test
+
+import (
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestGetRichTextString(t *testing.T) {
+	text := func(s string) notionapi.RichText {
+		return notionapi.RichText{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: s}}
+	}
+
+	tests := []struct {
+		name  string
+		block notionapi.Block
+		want  string
+	}{
+		{"paragraph", notionapi.NewParagraph(text("hello "), text("world")), "hello world"},
+		{"code", notionapi.NewCode("go", "fmt.Println()"), "fmt.Println()"},
+		{"table row", notionapi.NewTableRow([]notionapi.RichText{text("a")}, nil, []notionapi.RichText{text("c")}), "a\t\tc"},
+		{"child page", &notionapi.ChildPageBlock{ChildPage: notionapi.ChildPage{Title: "Sub"}}, "Sub"},
+		{"child database", &notionapi.ChildDatabaseBlock{ChildDatabase: notionapi.ChildDatabase{Title: "Tasks"}}, "Tasks"},
+		{"divider", notionapi.NewDivider(), ""},
+		{"column list", notionapi.NewColumns([]notionapi.Block{notionapi.NewParagraph(text("x"))}), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.block.GetRichTextString(); got != tt.want {
+				t.Errorf("GetRichTextString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractText(t *testing.T) {
+	text := func(s string) notionapi.RichText {
+		return notionapi.RichText{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: s}}
+	}
+
+	tree := notionapi.Blocks{
+		notionapi.NewHeading(1, text("Title")),
+		notionapi.NewParagraph(text("Intro")),
+		notionapi.NewDivider(),
+		notionapi.NewHeading(2, text("Section")),
+		notionapi.NewToggle("More", notionapi.NewBulletedListItem(text("hidden"))),
+		notionapi.NewColumns(
+			[]notionapi.Block{notionapi.NewParagraph(text("left"))},
+			[]notionapi.Block{notionapi.NewParagraph(text("right"))},
+		),
+		notionapi.NewToggleHeading(3, []notionapi.RichText{text("Details")}, notionapi.NewCode("go", "x := 1")),
+		notionapi.NewTable([][]string{{"a", "b"}, {"1", "2"}}, true),
+	}
+	want := "# Title\nIntro\n## Section\nMore\nhidden\nleft\nright\n### Details\nx := 1\na\tb\n1\t2"
+	if got := notionapi.ExtractText(tree); got != want {
+		t.Errorf("ExtractText() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,412 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+)
+
+// TreeOptions configures BlockClient.GetTree.
+type TreeOptions struct {
+	// MaxDepth limits how many levels of children are fetched. Zero fetches
+	// the whole tree.
+	MaxDepth int
+	// InlineSyncedBlocks replaces every synced block reference with the
+	// content of its original block.
+	InlineSyncedBlocks bool
+}
+
+// GetTree returns all children of the block or page with the given ID,
+// following pagination and fetching the children of nested blocks
+// recursively. The children of every block are stored in its Children field,
+// e.g. Paragraph.Children or ColumnList.Children.
+//
+// Child pages and child databases are returned without their content, since
+// it belongs to another page.
+func (bc *BlockClient) GetTree(ctx context.Context, id BlockID, opts *TreeOptions) (Blocks, error) {
+	if opts == nil {
+		opts = &TreeOptions{}
+	}
+	return bc.getTree(ctx, id, opts, 1)
+}
+
+func (bc *BlockClient) getTree(ctx context.Context, id BlockID, opts *TreeOptions, depth int) (Blocks, error) {
+	blocks, err := getAllChildren(ctx, bc, id)
+	if err != nil {
+		return nil, err
+	}
+	if opts.MaxDepth > 0 && depth >= opts.MaxDepth {
+		return blocks, nil
+	}
+
+	result := make(Blocks, 0, len(blocks))
+	for _, b := range blocks {
+		if !b.GetHasChildren() || !canHaveChildren(b) {
+			result = append(result, b)
+			continue
+		}
+		// The children of a synced block reference are the children of its
+		// original block.
+		children, err := bc.getTree(ctx, b.GetID(), opts, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if opts.InlineSyncedBlocks && isSyncedReference(b) {
+			result = append(result, children...)
+			continue
+		}
+		setBlockChildren(b, children)
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// getAllChildren returns the direct children of the block, following
+// pagination.
+func getAllChildren(ctx context.Context, service BlockService, id BlockID) (Blocks, error) {
+	var blocks Blocks
+	pagination := &Pagination{PageSize: MaxArrayLength}
+	for {
+		res, err := service.GetChildren(ctx, id, pagination)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, res.Results...)
+		if !res.HasMore || res.NextCursor == "" {
+			return blocks, nil
+		}
+		pagination.StartCursor = Cursor(res.NextCursor)
+	}
+}
+
+// AppendTree appends blocks and all of their nested children to the block or
+// page with the given ID and returns the created top level blocks. If after is
+// set, the blocks are inserted after that block.
+//
+// Blocks are usually the result of GetTree. Their IDs, timestamps and other
+// read-only fields are ignored, so a tree read from one page can be appended
+// to another one. Notion only accepts two levels of nesting per request, so
+// deeper children are appended with additional requests once their parents
+// exist. Blocks that cannot be created through the API, like child pages,
+// link previews or files uploaded to Notion, are skipped.
+func (bc *BlockClient) AppendTree(ctx context.Context, id BlockID, after BlockID, blocks Blocks) (Blocks, error) {
+	a := &treeAppender{client: bc}
+	return a.append(ctx, id, after, blocks)
+}
+
+// treeAppender creates copies of block trees and records the IDs of the
+// copies.
+type treeAppender struct {
+	client BlockService
+	// ids maps the IDs of the appended blocks to the IDs of their copies.
+	// Nothing is recorded if it is nil.
+	ids map[ObjectID]ObjectID
+	// skipped collects the IDs of blocks that could not be created.
+	skipped []BlockID
+	// handle is called instead of appending blocks for which handles returns
+	// true. Blocks preceding such a block are appended first, so the order
+	// of blocks is kept when handle creates content at the end of the parent.
+	handles func(Block) bool
+	handle  func(context.Context, Block) error
+}
+
+func (a *treeAppender) append(ctx context.Context, id BlockID, after BlockID, blocks Blocks) (Blocks, error) {
+	var created, pending, originals Blocks
+	flush := func() error {
+		res, err := a.appendLevel(ctx, id, after, pending, originals)
+		if err != nil {
+			return err
+		}
+		created = append(created, res...)
+		if n := len(res); after != "" && n > 0 {
+			after = res[n-1].GetID()
+		}
+		pending, originals = nil, nil
+		return nil
+	}
+
+	for _, b := range blocks {
+		if a.handles != nil && a.handles(b) {
+			if len(pending) > 0 {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			if err := a.handle(ctx, b); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !isCreatable(b) {
+			a.skipped = append(a.skipped, b.GetID())
+			continue
+		}
+		c, err := a.creatableCopy(b)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, c)
+		originals = append(originals, b)
+	}
+	if len(pending) > 0 {
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// appendLevel appends blocks, the creatable copies of originals, to the
+// block with the given ID and then appends the remaining children of the
+// originals to the created blocks.
+func (a *treeAppender) appendLevel(ctx context.Context, id BlockID, after BlockID, blocks, originals Blocks) (Blocks, error) {
+	var created Blocks
+	for _, chunk := range chunkBlocks(blocks) {
+		res, err := a.client.AppendChildren(ctx, id, &AppendBlockChildrenRequest{After: after, Children: chunk})
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, res.Results...)
+		if n := len(res.Results); n > 0 {
+			after = res.Results[n-1].GetID()
+		}
+	}
+
+	for i, b := range created {
+		if i >= len(originals) {
+			break
+		}
+		if err := a.descend(ctx, originals[i], blocks[i], b); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// descend records the ID of created, the copy of original, and appends the
+// children of original that were not sent along with sent.
+func (a *treeAppender) descend(ctx context.Context, original, sent, created Block) error {
+	a.record(original.GetID(), created.GetID())
+
+	children := blockChildren(original)
+	if len(children) == 0 || isSyncedReference(original) {
+		// The children of a synced block reference are the content of the
+		// original block and cannot be appended to the reference.
+		return nil
+	}
+	if len(blockChildren(sent)) == 0 {
+		_, err := a.append(ctx, created.GetID(), "", children)
+		return err
+	}
+
+	// The children were created together with their parent, e.g. the
+	// columns of a column list or the rows of a table. Their IDs are not
+	// part of the response, so they have to be fetched to continue.
+	createdChildren, err := getAllChildren(ctx, a.client, created.GetID())
+	if err != nil {
+		return err
+	}
+	sentChildren := blockChildren(sent)
+	originalChildren := creatableBlocks(children)
+	for i, child := range createdChildren {
+		if i >= len(originalChildren) || i >= len(sentChildren) {
+			break
+		}
+		if err := a.descend(ctx, originalChildren[i], sentChildren[i], child); err != nil {
+			return err
+		}
+	}
+
+	// Children that did not fit into the request, e.g. the rows of a large
+	// table, are appended after the created ones.
+	if n := len(createdChildren); n > 0 && n == len(sentChildren) && n < len(originalChildren) {
+		_, err = a.append(ctx, created.GetID(), "", originalChildren[n:])
+	}
+	return err
+}
+
+func (a *treeAppender) record(original, created BlockID) {
+	if a.ids != nil && original != "" {
+		a.ids[ObjectID(original)] = ObjectID(created)
+	}
+}
+
+// creatableCopy returns a copy of b without read-only fields. Column lists
+// keep their columns with the columns' direct children, and tables keep their
+// rows, because Notion requires them when the block is created. All other
+// children are removed and appended separately.
+func (a *treeAppender) creatableCopy(b Block) (Block, error) {
+	var children Blocks
+	switch b.(type) {
+	case *ColumnListBlock:
+		for _, column := range creatableBlocks(blockChildren(b)) {
+			var columnChildren Blocks
+			for _, child := range blockChildren(column) {
+				if !isCreatable(child) {
+					a.skipped = append(a.skipped, child.GetID())
+					continue
+				}
+				c, err := withoutReadOnlyFields(child, nil)
+				if err != nil {
+					return nil, err
+				}
+				columnChildren = append(columnChildren, c)
+			}
+			c, err := withoutReadOnlyFields(column, columnChildren)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, c)
+		}
+	case *TableBlock:
+		rows := blockChildren(b)
+		if len(rows) > MaxArrayLength {
+			rows = rows[:MaxArrayLength]
+		}
+		for _, row := range rows {
+			c, err := withoutReadOnlyFields(row, nil)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, c)
+		}
+	}
+	return withoutReadOnlyFields(b, children)
+}
+
+// readOnlyBlockFields are the fields of a block returned by the API that must
+// not be sent when the block is created.
+var readOnlyBlockFields = []string{
+	"id", "created_time", "created_by", "last_edited_time", "last_edited_by",
+	"has_children", "archived", "in_trash", "parent", "request_id",
+}
+
+// withoutReadOnlyFields returns a copy of b without read-only fields and with
+// its children replaced by children.
+func withoutReadOnlyFields(b Block, children Blocks) (Block, error) {
+	data, err := marshalBlock(b)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	for _, field := range readOnlyBlockFields {
+		delete(raw, field)
+	}
+	raw["object"] = ObjectTypeBlock.String()
+	if payload, ok := raw[b.GetType().String()].(map[string]any); ok {
+		delete(payload, "children")
+		if len(children) > 0 {
+			payload["children"] = children
+		}
+	}
+	return decodeBlock(raw)
+}
+
+// newBlocks returns copies of blocks and their nested children without
+// read-only fields, for requests creating them. Blocks without a type are
+// kept as they are.
+func newBlocks(blocks Blocks) (Blocks, error) {
+	if blocks == nil {
+		return nil, nil
+	}
+	result := make(Blocks, len(blocks))
+	for i, b := range blocks {
+		if b == nil || b.GetType() == "" {
+			result[i] = b
+			continue
+		}
+		children, err := newBlocks(blockChildren(b))
+		if err != nil {
+			return nil, err
+		}
+		if result[i], err = withoutReadOnlyFields(b, children); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// isCreatable reports whether a copy of b can be created through the API.
+func isCreatable(b Block) bool {
+	switch b := b.(type) {
+	case *ChildPageBlock, *ChildDatabaseBlock, *LinkPreviewBlock, *TemplateBlock, *UnsupportedBlock, *UnknownBlock:
+		return false
+	case *ImageBlock:
+		return b.Image.Type != FileTypeFile
+	case *VideoBlock:
+		return b.Video.Type != FileTypeFile
+	case *AudioBlock:
+		return b.Audio.Type != FileTypeFile
+	case *FileBlock:
+		return b.File.Type != FileTypeFile
+	case *PdfBlock:
+		return b.Pdf.Type != FileTypeFile
+	}
+	return true
+}
+
+func isSyncedReference(b Block) bool {
+	synced, ok := b.(*SyncedBlock)
+	return ok && synced.SyncedBlock.SyncedFrom != nil
+}
+
+func creatableBlocks(blocks Blocks) Blocks {
+	var result Blocks
+	for _, b := range blocks {
+		if isCreatable(b) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+var blocksType = reflect.TypeOf(Blocks(nil))
+
+// childrenField returns the Children field of the type specific object of
+// b, e.g. Paragraph.Children, or false if the block cannot have children.
+func childrenField(b Block) (reflect.Value, bool) {
+	v := reflect.ValueOf(b)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if v.Type().Field(i).Anonymous || field.Kind() != reflect.Struct {
+			continue
+		}
+		children := field.FieldByName("Children")
+		if children.IsValid() && children.Type() == blocksType {
+			return children, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func canHaveChildren(b Block) bool {
+	switch b.(type) {
+	case *ChildPageBlock, *ChildDatabaseBlock:
+		return false
+	}
+	_, ok := childrenField(b)
+	return ok
+}
+
+// blockChildren returns the children stored in b.
+func blockChildren(b Block) Blocks {
+	if field, ok := childrenField(b); ok {
+		return field.Interface().(Blocks)
+	}
+	return nil
+}
+
+// setBlockChildren stores children in b. It does nothing for blocks that
+// cannot have children.
+func setBlockChildren(b Block, children Blocks) {
+	if field, ok := childrenField(b); ok {
+		field.Set(reflect.ValueOf(children))
+	}
+}
@@ -0,0 +1,156 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// unknownFields holds the fields of a block returned by the API that the
+// block structs do not model: Fields at the top level of the block and
+// Payload inside the type specific object, e.g. "paragraph". They are
+// merged back when the block is marshaled as part of Blocks, see
+// marshalBlock, so that blocks read from the API can be modified and sent
+// again without losing data.
+type unknownFields struct {
+	Fields  map[string]json.RawMessage
+	Payload map[string]json.RawMessage
+}
+
+func (b *BasicBlock) setUnknownFields(u *unknownFields) {
+	b.unknown = u
+}
+
+func (b BasicBlock) getUnknownFields() *unknownFields {
+	return b.unknown
+}
+
+// collectUnknownFields compares raw with the struct b was decoded into and
+// stores every field of raw the struct does not know on b. Read-only fields,
+// see readOnlyBlockFields, are left out as the API rejects them in requests.
+func collectUnknownFields(b Block, raw map[string]any) error {
+	setter, ok := b.(interface{ setUnknownFields(*unknownFields) })
+	if !ok {
+		return nil
+	}
+
+	t := reflect.TypeOf(b)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	known := jsonFieldTypes(t)
+	blockType := b.GetType().String()
+
+	u := &unknownFields{}
+	var err error
+	for k, v := range raw {
+		if _, ok := known[k]; ok || isReadOnlyBlockField(k) {
+			continue
+		}
+		if u.Fields == nil {
+			u.Fields = map[string]json.RawMessage{}
+		}
+		if u.Fields[k], err = json.Marshal(v); err != nil {
+			return err
+		}
+	}
+
+	payloadType, ok := known[blockType]
+	payload, isMap := raw[blockType].(map[string]any)
+	if ok && isMap && payloadType.Kind() == reflect.Struct {
+		knownPayload := jsonFieldTypes(payloadType)
+		for k, v := range payload {
+			if _, ok := knownPayload[k]; ok {
+				continue
+			}
+			if u.Payload == nil {
+				u.Payload = map[string]json.RawMessage{}
+			}
+			if u.Payload[k], err = json.Marshal(v); err != nil {
+				return err
+			}
+		}
+	}
+
+	if u.Fields != nil || u.Payload != nil {
+		setter.setUnknownFields(u)
+	}
+	return nil
+}
+
+// jsonFieldTypes returns the types of the fields of the struct type t keyed
+// by their JSON names, including the fields of embedded structs.
+func jsonFieldTypes(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for k, v := range jsonFieldTypes(field.Type) {
+				fields[k] = v
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name, ok := jsonFieldName(field); ok {
+			fields[name] = field.Type
+		}
+	}
+	return fields
+}
+
+// isReadOnlyBlockField reports whether the top-level block field k is one of
+// readOnlyBlockFields.
+func isReadOnlyBlockField(k string) bool {
+	for _, field := range readOnlyBlockFields {
+		if field == k {
+			return true
+		}
+	}
+	return false
+}
+
+// marshalBlock marshals b and merges its unknown fields back into the
+// result.
+func marshalBlock(b Block) ([]byte, error) {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return nil, err
+	}
+	getter, ok := b.(interface{ getUnknownFields() *unknownFields })
+	if !ok {
+		return data, nil
+	}
+	u := getter.getUnknownFields()
+	if u == nil {
+		return data, nil
+	}
+	key := b.GetType().String()
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range u.Fields {
+		if _, ok := fields[k]; !ok {
+			fields[k] = v
+		}
+	}
+	if len(u.Payload) > 0 {
+		payload := map[string]json.RawMessage{}
+		if raw, ok := fields[key]; ok {
+			if err := json.Unmarshal(raw, &payload); err != nil {
+				return nil, err
+			}
+		}
+		for k, v := range u.Payload {
+			if _, ok := payload[k]; !ok {
+				payload[k] = v
+			}
+		}
+		if fields[key], err = json.Marshal(payload); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,148 @@
+package notionapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrBlockNotUpdatable is returned by BlockClient.UpdateBlock for blocks whose
+// type cannot be updated through the API, like child pages or columns, and
+// for files uploaded to Notion, whose hosted URLs cannot be sent back.
+var ErrBlockNotUpdatable = errors.New("block type cannot be updated")
+
+// UpdateBlock updates the block with the ID of b to the content of b. The
+// request is derived from the type of b, e.g. a *ToDoBlock updates the to-do
+// fields. Children and the read-only fields of BasicBlock are not sent; use
+// AppendChildren to add children.
+//
+// b must be a pointer to a block, as returned by Get or GetChildren.
+func (bc *BlockClient) UpdateBlock(ctx context.Context, b Block) (Block, error) {
+	if b == nil || b.GetID() == "" {
+		return nil, errors.New("block without id")
+	}
+	req, ok := updateRequestFor(b)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBlockNotUpdatable, b.GetType())
+	}
+	return bc.Update(ctx, b.GetID(), req)
+}
+
+// updateRequestFor returns the request updating a block to the content of
+// b, without its children, and false if blocks of its type cannot be
+// updated. Like isCreatable, it rejects files hosted by Notion.
+func updateRequestFor(b Block) (*BlockUpdateRequest, bool) {
+	switch b := b.(type) {
+	case *ParagraphBlock:
+		p := b.Paragraph
+		p.Children = nil
+		return &BlockUpdateRequest{Paragraph: &p}, true
+	case *Heading1Block:
+		h := b.Heading1
+		h.Children = nil
+		return &BlockUpdateRequest{Heading1: &h}, true
+	case *Heading2Block:
+		h := b.Heading2
+		h.Children = nil
+		return &BlockUpdateRequest{Heading2: &h}, true
+	case *Heading3Block:
+		h := b.Heading3
+		h.Children = nil
+		return &BlockUpdateRequest{Heading3: &h}, true
+	case *BulletedListItemBlock:
+		l := b.BulletedListItem
+		l.Children = nil
+		return &BlockUpdateRequest{BulletedListItem: &l}, true
+	case *NumberedListItemBlock:
+		l := b.NumberedListItem
+		l.Children = nil
+		return &BlockUpdateRequest{NumberedListItem: &l}, true
+	case *CodeBlock:
+		c := b.Code
+		return &BlockUpdateRequest{Code: &c}, true
+	case *ToDoBlock:
+		t := b.ToDo
+		t.Children = nil
+		return &BlockUpdateRequest{ToDo: &t}, true
+	case *ToggleBlock:
+		t := b.Toggle
+		t.Children = nil
+		return &BlockUpdateRequest{Toggle: &t}, true
+	case *EmbedBlock:
+		e := b.Embed
+		return &BlockUpdateRequest{Embed: &e}, true
+	case *ImageBlock:
+		if b.Image.Type == FileTypeFile {
+			return nil, false
+		}
+		i := b.Image
+		return &BlockUpdateRequest{Image: &i}, true
+	case *VideoBlock:
+		if b.Video.Type == FileTypeFile {
+			return nil, false
+		}
+		v := b.Video
+		return &BlockUpdateRequest{Video: &v}, true
+	case *FileBlock:
+		if b.File.Type == FileTypeFile {
+			return nil, false
+		}
+		f := b.File
+		return &BlockUpdateRequest{File: &f}, true
+	case *PdfBlock:
+		if b.Pdf.Type == FileTypeFile {
+			return nil, false
+		}
+		p := b.Pdf
+		return &BlockUpdateRequest{Pdf: &p}, true
+	case *BookmarkBlock:
+		bm := b.Bookmark
+		return &BlockUpdateRequest{Bookmark: &bm}, true
+	case *TemplateBlock:
+		t := b.Template
+		t.Children = nil
+		return &BlockUpdateRequest{Template: &t}, true
+	case *CalloutBlock:
+		c := b.Callout
+		c.Children = nil
+		return &BlockUpdateRequest{Callout: &c}, true
+	case *EquationBlock:
+		e := b.Equation
+		return &BlockUpdateRequest{Equation: &e}, true
+	case *QuoteBlock:
+		q := b.Quote
+		q.Children = nil
+		return &BlockUpdateRequest{Quote: &q}, true
+	case *TableRowBlock:
+		t := b.TableRow
+		return &BlockUpdateRequest{TableRow: &t}, true
+	case *TableBlock:
+		return &BlockUpdateRequest{Table: &TableUpdate{
+			HasColumnHeader: b.Table.HasColumnHeader,
+			HasRowHeader:    b.Table.HasRowHeader,
+		}}, true
+	case *DividerBlock:
+		return &BlockUpdateRequest{Divider: &Divider{}}, true
+	case *AudioBlock:
+		if b.Audio.Type == FileTypeFile {
+			return nil, false
+		}
+		a := b.Audio
+		return &BlockUpdateRequest{Audio: &a}, true
+	case *BreadcrumbBlock:
+		return &BlockUpdateRequest{Breadcrumb: &Breadcrumb{}}, true
+	case *TableOfContentsBlock:
+		t := b.TableOfContents
+		return &BlockUpdateRequest{TableOfContents: &t}, true
+	case *LinkToPageBlock:
+		l := b.LinkToPage
+		return &BlockUpdateRequest{LinkToPage: &l}, true
+	case *SyncedBlock:
+		s := b.SyncedBlock
+		s.Children = nil
+		return &BlockUpdateRequest{SyncedBlock: &s}, true
+	case *ColumnListBlock:
+		return &BlockUpdateRequest{ColumnList: &ColumnListUpdate{}}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,117 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestBlockClient_UpdateBlock(t *testing.T) {
+	text := []notionapi.RichText{{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: "x"}}}
+	basic := func(blockType notionapi.BlockType) notionapi.BasicBlock {
+		return notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, ID: "some_id", Type: blockType, HasChildren: true}
+	}
+
+	tests := []struct {
+		name     string
+		block    notionapi.Block
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name: "to do",
+			block: &notionapi.ToDoBlock{
+				BasicBlock: basic(notionapi.BlockTypeToDo),
+				ToDo:       notionapi.ToDo{RichText: text, Checked: true, Children: notionapi.Blocks{notionapi.NewDivider()}},
+			},
+			wantBody: `{"to_do":{"rich_text":[{"type":"text","text":{"content":"x"}}],"checked":true}}`,
+		},
+		{
+			name: "code",
+			block: &notionapi.CodeBlock{
+				BasicBlock: basic(notionapi.BlockTypeCode),
+				Code:       notionapi.Code{RichText: text, Language: "go"},
+			},
+			wantBody: `{"code":{"rich_text":[{"type":"text","text":{"content":"x"}}],"language":"go"}}`,
+		},
+		{
+			name: "table",
+			block: &notionapi.TableBlock{
+				BasicBlock: basic(notionapi.BlockTypeTableBlock),
+				Table:      notionapi.Table{TableWidth: 3, HasRowHeader: true},
+			},
+			wantBody: `{"table":{"has_column_header":false,"has_row_header":true}}`,
+		},
+		{
+			name:    "child page",
+			block:   &notionapi.ChildPageBlock{BasicBlock: basic(notionapi.BlockTypeChildPage)},
+			wantErr: notionapi.ErrBlockNotUpdatable,
+		},
+		{
+			name:    "column",
+			block:   &notionapi.ColumnBlock{BasicBlock: basic(notionapi.BlockTypeColumn)},
+			wantErr: notionapi.ErrBlockNotUpdatable,
+		},
+		{
+			name: "external image",
+			block: &notionapi.ImageBlock{
+				BasicBlock: basic(notionapi.BlockTypeImage),
+				Image:      notionapi.Image{Type: notionapi.FileTypeExternal, External: &notionapi.FileObject{URL: "https://example.com/a.png"}},
+			},
+			wantBody: `{"image":{"type":"external","external":{"url":"https://example.com/a.png"}}}`,
+		},
+		{
+			name: "uploaded image",
+			block: &notionapi.ImageBlock{
+				BasicBlock: basic(notionapi.BlockTypeImage),
+				Image:      notionapi.Image{Type: notionapi.FileTypeFile, File: &notionapi.FileObject{URL: "https://s3.example.com/a.png"}},
+			},
+			wantErr: notionapi.ErrBlockNotUpdatable,
+		},
+		{
+			name: "uploaded pdf",
+			block: &notionapi.PdfBlock{
+				BasicBlock: basic(notionapi.BlockTypePdf),
+				Pdf:        notionapi.Pdf{Type: notionapi.FileTypeFile, File: &notionapi.FileObject{URL: "https://s3.example.com/a.pdf"}},
+			},
+			wantErr: notionapi.ErrBlockNotUpdatable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody string
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(newTestClient(func(req *http.Request) *http.Response {
+				body, _ := io.ReadAll(req.Body)
+				gotBody = string(body)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"object":"block","id":"some_id","type":"divider","divider":{}}`)),
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+				}
+			})))
+
+			_, err := client.Block.UpdateBlock(context.Background(), tt.block)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("UpdateBlock() error = %v, want %v", err, tt.wantErr)
+				}
+				if gotBody != "" {
+					t.Errorf("UpdateBlock() sent a request for a block that cannot be updated")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotBody != tt.wantBody {
+				t.Errorf("UpdateBlock() sent %s, want %s", gotBody, tt.wantBody)
+			}
+		})
+	}
+}
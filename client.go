@@ -40,6 +40,9 @@ type Client struct {
 
 	maxRetries int
 
+	validateLimits bool
+	autoFixLimits  bool
+
 	Token Token
 
 	// used in Authorization header only for requests that require Basic authentication.
@@ -105,6 +108,27 @@ func WithRetry(retries int) ClientOption {
 	}
 }
 
+// WithLimitValidation makes the client check page create, block append, block
+// update and database create requests against Notion's size limits before
+// sending them. Requests that exceed a limit fail with a *LimitError without
+// reaching the API.
+func WithLimitValidation() ClientOption {
+	return func(c *Client) {
+		c.validateLimits = true
+	}
+}
+
+// WithAutoFixLimits makes the client fix requests that exceed Notion's size
+// limits where possible: rich text longer than MaxRichTextContentLength is
+// split into several rich text objects, and top-level children arrays longer
+// than MaxArrayLength are sent in several requests. Requests are modified in
+// place.
+func WithAutoFixLimits() ClientOption {
+	return func(c *Client) {
+		c.autoFixLimits = true
+	}
+}
+
 // WithOAuthAppCredentials sets the OAuth app ID and secret to use when fetching a token from Notion.
 func WithOAuthAppCredentials(id, secret string) ClientOption {
 	return func(c *Client) {
@@ -117,6 +141,18 @@ func (c *Client) request(ctx context.Context, method string, urlStr string, quer
 	return c.requestImpl(ctx, method, urlStr, queryParams, requestBody, false, decodeClientError)
 }
 
+// prepareRequest applies the limit options of the client to a request body
+// before it is sent.
+func (c *Client) prepareRequest(requestBody interface{ Validate() error }) error {
+	if c.autoFixLimits {
+		fixLimits(reflect.ValueOf(requestBody))
+	}
+	if c.validateLimits {
+		return requestBody.Validate()
+	}
+	return nil
+}
+
 func (c *Client) requestImpl(ctx context.Context, method string, urlStr string, queryParams map[string]string, requestBody any, basicAuth bool, errDecoder errJsonDecodeFunc) (*http.Response, error) {
 	u, err := c.baseUrl.Parse(fmt.Sprintf("%s/%s", c.apiVersion, urlStr))
 	if err != nil {
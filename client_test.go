@@ -2,6 +2,7 @@ package notionapi_test
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -39,6 +40,12 @@ func newTestClient(fn RoundTripFunc) *http.Client {
 	return hc
 }
 
+// ptr returns a pointer to v, for optional fields of request and response
+// types.
+func ptr[T any](v T) *T {
+	return &v
+}
+
 // newMockedClient returns *http.Client which responds with content from given file
 func newMockedClient(t *testing.T, requestMockFile string, statusCode int) *http.Client {
 	return newTestClient(func(*http.Request) *http.Response {
@@ -128,3 +135,20 @@ func TestBasicAuthHeader(t *testing.T) {
 	client := notionapi.NewClient("some_token", opts...)
 	_, _ = client.Authentication.CreateToken(context.Background(), &notionapi.TokenCreateRequest{})
 }
+
+// newRecordingClient returns *http.Client which stores the body of every
+// request in body and responds with content from given file
+func newRecordingClient(t *testing.T, responseMockFile string, body *string) *http.Client {
+	return newTestClient(func(req *http.Request) *http.Response {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*body = string(data)
+		b, err := os.Open(responseMockFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: b, Header: http.Header{"Content-Type": []string{"application/json"}}}
+	})
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tenz-io/notionapi"
+)
+
+// fieldTypes maps property types to the Go type of their struct fields.
+// Properties of other types, like buttons, get no field. Numbers are
+// pointers, so an empty number is not read as zero.
+var fieldTypes = map[notionapi.PropertyConfigType]string{
+	notionapi.PropertyConfigTypeTitle:       "string",
+	notionapi.PropertyConfigTypeRichText:    "string",
+	notionapi.PropertyConfigTypeNumber:      "*float64",
+	notionapi.PropertyConfigTypeSelect:      "string",
+	notionapi.PropertyConfigTypeMultiSelect: "[]string",
+	notionapi.PropertyConfigTypeDate:        "*notionapi.DateObject",
+	notionapi.PropertyConfigTypePeople:      "[]notionapi.UserID",
+	notionapi.PropertyConfigTypeFiles:       "[]notionapi.File",
+	notionapi.PropertyConfigTypeCheckbox:    "bool",
+	notionapi.PropertyConfigTypeURL:         "string",
+	notionapi.PropertyConfigTypeEmail:       "string",
+	notionapi.PropertyConfigTypePhoneNumber: "string",
+	notionapi.PropertyConfigTypeFormula:     "notionapi.Formula",
+	notionapi.PropertyConfigTypeRelation:    "[]notionapi.PageID",
+	notionapi.PropertyConfigTypeRollup:      "notionapi.Rollup",
+	notionapi.PropertyConfigCreatedTime:     "time.Time",
+	notionapi.PropertyConfigCreatedBy:       "notionapi.User",
+	notionapi.PropertyConfigLastEditedTime:  "time.Time",
+	notionapi.PropertyConfigLastEditedBy:    "notionapi.User",
+	notionapi.PropertyConfigStatus:          "string",
+	notionapi.PropertyConfigUniqueID:        "notionapi.UniqueID",
+	notionapi.PropertyConfigVerification:    "notionapi.Verification",
+}
+
+// initialisms are words written in upper case in Go identifiers.
+var initialisms = map[string]bool{
+	"API": true, "CSS": true, "HTML": true, "HTTP": true, "ID": true,
+	"JSON": true, "SQL": true, "URL": true, "UUID": true,
+}
+
+type field struct {
+	name     string
+	property string
+	id       notionapi.PropertyID
+	typ      string
+	tag      string
+	options  []notionapi.Option
+}
+
+// generate returns the formatted Go source for db. If typeName is empty, it
+// is derived from the title of the database.
+func generate(db *notionapi.Database, pkg, typeName string) ([]byte, error) {
+	title := notionapi.PlainText(db.Title)
+	if typeName == "" {
+		typeName = identifier(title)
+	}
+	if typeName == "" {
+		typeName = "Row"
+	}
+
+	names := make([]string, 0, len(db.Properties))
+	for name := range db.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	used := map[string]bool{}
+	var fields []field
+	usesTime := false
+	for _, name := range names {
+		config := db.Properties[name]
+		typ, ok := fieldTypes[config.GetType()]
+		if !ok {
+			continue
+		}
+		usesTime = usesTime || strings.HasPrefix(typ, "time.")
+		fields = append(fields, field{
+			name:     unique(used, identifier(name), "Property"),
+			property: name,
+			id:       config.GetID(),
+			typ:      typ,
+			tag:      fmt.Sprintf("notion:%q", name+","+string(config.GetType())),
+			options:  options(config),
+		})
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by notion-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	if usesTime {
+		fmt.Fprintf(&b, "import (\n\t\"time\"\n\n\t\"github.com/tenz-io/notionapi\"\n)\n\n")
+	} else {
+		fmt.Fprintf(&b, "import \"github.com/tenz-io/notionapi\"\n\n")
+	}
+
+	fmt.Fprintf(&b, "// %sDatabaseID is the ID of the database %q.\n", typeName, title)
+	fmt.Fprintf(&b, "const %sDatabaseID notionapi.DatabaseID = %q\n\n", typeName, db.ID)
+
+	fmt.Fprintf(&b, "// %s is a row of the database %q.\n", typeName, title)
+	fmt.Fprintf(&b, "type %s struct {\n", typeName)
+	for _, f := range fields {
+		fmt.Fprintf(&b, "\t%s %s `%s`\n", f.name, f.typ, f.tag)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	// declared holds the names of the generated constants, so option
+	// constants do not collide with them.
+	declared := map[string]bool{typeName: true, typeName + "DatabaseID": true}
+	fmt.Fprintf(&b, "// Names and IDs of the properties of %s.\nconst (\n", typeName)
+	for _, f := range fields {
+		name := typeName + "Property" + f.name
+		declared[name] = true
+		fmt.Fprintf(&b, "\t%s = %q\n", name, f.property)
+		if f.id != "" {
+			declared[name+"ID"] = true
+			fmt.Fprintf(&b, "\t%sID = %q\n", name, f.id)
+		}
+	}
+	fmt.Fprintf(&b, ")\n")
+
+	for _, f := range fields {
+		if len(f.options) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n// Options of %s.%s.\nconst (\n", typeName, f.name)
+		for _, option := range f.options {
+			name := unique(declared, typeName+f.name+identifier(option.Name), "")
+			fmt.Fprintf(&b, "\t%s = %q\n", name, option.Name)
+		}
+		fmt.Fprintf(&b, ")\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+func options(config notionapi.PropertyConfig) []notionapi.Option {
+	switch c := config.(type) {
+	case *notionapi.SelectPropertyConfig:
+		return c.Select.Options
+	case *notionapi.MultiSelectPropertyConfig:
+		return c.MultiSelect.Options
+	case *notionapi.StatusPropertyConfig:
+		return c.Status.Options
+	}
+	return nil
+}
+
+// identifier turns s into an exported Go identifier by joining its words,
+// e.g. "due date" into "DueDate".
+func identifier(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+	result := b.String()
+	if result != "" && !unicode.IsLetter([]rune(result)[0]) {
+		result = "X" + result
+	}
+	return result
+}
+
+// unique returns name, or name with a number appended if it is already used,
+// and marks the result as used. An empty name is replaced by fallback.
+func unique(used map[string]bool, name, fallback string) string {
+	if name == "" {
+		name = fallback
+	}
+	if name == "" {
+		name = "X"
+	}
+	result := name
+	for i := 2; used[result]; i++ {
+		result = name + strconv.Itoa(i)
+	}
+	used[result] = true
+	return result
+}
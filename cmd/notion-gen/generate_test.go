@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	db, err := loadDatabase("testdata/tasks.json", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(db, "tasks", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *update {
+		if err := os.WriteFile("testdata/tasks.golden", got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile("testdata/tasks.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("generate() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"Due date":     "DueDate",
+		"story_points": "StoryPoints",
+		"page url":     "PageURL",
+		"1st review":   "X1stReview",
+		"!!":           "",
+		"Größe":        "Größe",
+	}
+	for in, want := range tests {
+		if got := identifier(in); got != want {
+			t.Errorf("identifier(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
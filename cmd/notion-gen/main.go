@@ -0,0 +1,97 @@
+// Command notion-gen generates Go code for the rows of a Notion database: a
+// struct with a field per property, tagged for notionapi.UnmarshalPage and
+// notionapi.MarshalProperties, and constants for the names and IDs of the
+// properties and for the options of select, multi-select and status
+// properties. Regenerating the code after the database changed turns schema
+// drift into compile errors.
+//
+// Usage:
+//
+//	notion-gen [flags] <database ID>
+//	notion-gen [flags] -schema <file>
+//
+// Without -schema the database is retrieved from the API with the
+// integration token in NOTION_TOKEN. A schema file holds a database object
+// as returned by the retrieve database endpoint.
+//
+// The flags are:
+//
+//	-package name
+//		package of the generated file (default "notiondb")
+//	-type name
+//		name of the generated struct, derived from the database title by default
+//	-o file
+//		write the generated code to file instead of standard output
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("notion-gen: ")
+
+	schema := flag.String("schema", "", "read the database from this JSON file instead of the API")
+	pkg := flag.String("package", "notiondb", "package of the generated file")
+	typeName := flag.String("type", "", "name of the generated struct, derived from the database title by default")
+	out := flag.String("o", "", "output file, standard output by default")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: notion-gen [flags] <database ID>\n       notion-gen [flags] -schema <file>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	db, err := loadDatabase(*schema, flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(db, *pkg, *typeName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadDatabase reads the database from the schema file if it is set and
+// retrieves the database with the given ID otherwise.
+func loadDatabase(schema string, id string) (*notionapi.Database, error) {
+	if schema != "" {
+		data, err := os.ReadFile(schema)
+		if err != nil {
+			return nil, err
+		}
+		var db notionapi.Database
+		if err := json.Unmarshal(data, &db); err != nil {
+			return nil, fmt.Errorf("%s: %w", schema, err)
+		}
+		return &db, nil
+	}
+
+	if id == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	token := os.Getenv("NOTION_TOKEN")
+	if token == "" {
+		return nil, errors.New("NOTION_TOKEN is not set")
+	}
+	client := notionapi.NewClient(notionapi.Token(token))
+	return client.Database.Get(context.Background(), notionapi.DatabaseID(id))
+}
@@ -0,0 +1,378 @@
+package notionapi
+
+import (
+	"path"
+	"strings"
+)
+
+// CodeLanguage is the language of a code block. Notion rejects code blocks
+// with languages it does not know.
+type CodeLanguage string
+
+func (l CodeLanguage) String() string {
+	return string(l)
+}
+
+// Code languages supported by Notion.
+//
+// See https://developers.notion.com/reference/block#code
+const (
+	CodeLanguageABAP                 CodeLanguage = "abap"
+	CodeLanguageAgda                 CodeLanguage = "agda"
+	CodeLanguageArduino              CodeLanguage = "arduino"
+	CodeLanguageASCIIArt             CodeLanguage = "ascii art"
+	CodeLanguageAssembly             CodeLanguage = "assembly"
+	CodeLanguageBash                 CodeLanguage = "bash"
+	CodeLanguageBasic                CodeLanguage = "basic"
+	CodeLanguageBNF                  CodeLanguage = "bnf"
+	CodeLanguageC                    CodeLanguage = "c"
+	CodeLanguageCSharp               CodeLanguage = "c#"
+	CodeLanguageCPlusPlus            CodeLanguage = "c++"
+	CodeLanguageClojure              CodeLanguage = "clojure"
+	CodeLanguageCoffeeScript         CodeLanguage = "coffeescript"
+	CodeLanguageCoq                  CodeLanguage = "coq"
+	CodeLanguageCSS                  CodeLanguage = "css"
+	CodeLanguageDart                 CodeLanguage = "dart"
+	CodeLanguageDhall                CodeLanguage = "dhall"
+	CodeLanguageDiff                 CodeLanguage = "diff"
+	CodeLanguageDocker               CodeLanguage = "docker"
+	CodeLanguageEBNF                 CodeLanguage = "ebnf"
+	CodeLanguageElixir               CodeLanguage = "elixir"
+	CodeLanguageElm                  CodeLanguage = "elm"
+	CodeLanguageErlang               CodeLanguage = "erlang"
+	CodeLanguageFSharp               CodeLanguage = "f#"
+	CodeLanguageFlow                 CodeLanguage = "flow"
+	CodeLanguageFortran              CodeLanguage = "fortran"
+	CodeLanguageGherkin              CodeLanguage = "gherkin"
+	CodeLanguageGLSL                 CodeLanguage = "glsl"
+	CodeLanguageGo                   CodeLanguage = "go"
+	CodeLanguageGraphQL              CodeLanguage = "graphql"
+	CodeLanguageGroovy               CodeLanguage = "groovy"
+	CodeLanguageHaskell              CodeLanguage = "haskell"
+	CodeLanguageHCL                  CodeLanguage = "hcl"
+	CodeLanguageHTML                 CodeLanguage = "html"
+	CodeLanguageIdris                CodeLanguage = "idris"
+	CodeLanguageJava                 CodeLanguage = "java"
+	CodeLanguageJavaScript           CodeLanguage = "javascript"
+	CodeLanguageJSON                 CodeLanguage = "json"
+	CodeLanguageJulia                CodeLanguage = "julia"
+	CodeLanguageKotlin               CodeLanguage = "kotlin"
+	CodeLanguageLaTeX                CodeLanguage = "latex"
+	CodeLanguageLess                 CodeLanguage = "less"
+	CodeLanguageLisp                 CodeLanguage = "lisp"
+	CodeLanguageLiveScript           CodeLanguage = "livescript"
+	CodeLanguageLLVMIR               CodeLanguage = "llvm ir"
+	CodeLanguageLua                  CodeLanguage = "lua"
+	CodeLanguageMakefile             CodeLanguage = "makefile"
+	CodeLanguageMarkdown             CodeLanguage = "markdown"
+	CodeLanguageMarkup               CodeLanguage = "markup"
+	CodeLanguageMATLAB               CodeLanguage = "matlab"
+	CodeLanguageMathematica          CodeLanguage = "mathematica"
+	CodeLanguageMermaid              CodeLanguage = "mermaid"
+	CodeLanguageNix                  CodeLanguage = "nix"
+	CodeLanguageNotionFormula        CodeLanguage = "notion formula"
+	CodeLanguageObjectiveC           CodeLanguage = "objective-c"
+	CodeLanguageOCaml                CodeLanguage = "ocaml"
+	CodeLanguagePascal               CodeLanguage = "pascal"
+	CodeLanguagePerl                 CodeLanguage = "perl"
+	CodeLanguagePHP                  CodeLanguage = "php"
+	CodeLanguagePlainText            CodeLanguage = "plain text"
+	CodeLanguagePowerShell           CodeLanguage = "powershell"
+	CodeLanguageProlog               CodeLanguage = "prolog"
+	CodeLanguageProtobuf             CodeLanguage = "protobuf"
+	CodeLanguagePureScript           CodeLanguage = "purescript"
+	CodeLanguagePython               CodeLanguage = "python"
+	CodeLanguageR                    CodeLanguage = "r"
+	CodeLanguageRacket               CodeLanguage = "racket"
+	CodeLanguageReason               CodeLanguage = "reason"
+	CodeLanguageRuby                 CodeLanguage = "ruby"
+	CodeLanguageRust                 CodeLanguage = "rust"
+	CodeLanguageSass                 CodeLanguage = "sass"
+	CodeLanguageScala                CodeLanguage = "scala"
+	CodeLanguageScheme               CodeLanguage = "scheme"
+	CodeLanguageSCSS                 CodeLanguage = "scss"
+	CodeLanguageShell                CodeLanguage = "shell"
+	CodeLanguageSmalltalk            CodeLanguage = "smalltalk"
+	CodeLanguageSolidity             CodeLanguage = "solidity"
+	CodeLanguageSQL                  CodeLanguage = "sql"
+	CodeLanguageSwift                CodeLanguage = "swift"
+	CodeLanguageTOML                 CodeLanguage = "toml"
+	CodeLanguageTypeScript           CodeLanguage = "typescript"
+	CodeLanguageVBNet                CodeLanguage = "vb.net"
+	CodeLanguageVerilog              CodeLanguage = "verilog"
+	CodeLanguageVHDL                 CodeLanguage = "vhdl"
+	CodeLanguageVisualBasic          CodeLanguage = "visual basic"
+	CodeLanguageWebAssembly          CodeLanguage = "webassembly"
+	CodeLanguageXML                  CodeLanguage = "xml"
+	CodeLanguageYAML                 CodeLanguage = "yaml"
+	CodeLanguageJavaCCPlusPlusCSharp CodeLanguage = "java/c/c++/c#"
+)
+
+// CodeLanguages lists every language supported by Notion.
+var CodeLanguages = []CodeLanguage{
+	CodeLanguageABAP,
+	CodeLanguageAgda,
+	CodeLanguageArduino,
+	CodeLanguageASCIIArt,
+	CodeLanguageAssembly,
+	CodeLanguageBash,
+	CodeLanguageBasic,
+	CodeLanguageBNF,
+	CodeLanguageC,
+	CodeLanguageCSharp,
+	CodeLanguageCPlusPlus,
+	CodeLanguageClojure,
+	CodeLanguageCoffeeScript,
+	CodeLanguageCoq,
+	CodeLanguageCSS,
+	CodeLanguageDart,
+	CodeLanguageDhall,
+	CodeLanguageDiff,
+	CodeLanguageDocker,
+	CodeLanguageEBNF,
+	CodeLanguageElixir,
+	CodeLanguageElm,
+	CodeLanguageErlang,
+	CodeLanguageFSharp,
+	CodeLanguageFlow,
+	CodeLanguageFortran,
+	CodeLanguageGherkin,
+	CodeLanguageGLSL,
+	CodeLanguageGo,
+	CodeLanguageGraphQL,
+	CodeLanguageGroovy,
+	CodeLanguageHaskell,
+	CodeLanguageHCL,
+	CodeLanguageHTML,
+	CodeLanguageIdris,
+	CodeLanguageJava,
+	CodeLanguageJavaScript,
+	CodeLanguageJSON,
+	CodeLanguageJulia,
+	CodeLanguageKotlin,
+	CodeLanguageLaTeX,
+	CodeLanguageLess,
+	CodeLanguageLisp,
+	CodeLanguageLiveScript,
+	CodeLanguageLLVMIR,
+	CodeLanguageLua,
+	CodeLanguageMakefile,
+	CodeLanguageMarkdown,
+	CodeLanguageMarkup,
+	CodeLanguageMATLAB,
+	CodeLanguageMathematica,
+	CodeLanguageMermaid,
+	CodeLanguageNix,
+	CodeLanguageNotionFormula,
+	CodeLanguageObjectiveC,
+	CodeLanguageOCaml,
+	CodeLanguagePascal,
+	CodeLanguagePerl,
+	CodeLanguagePHP,
+	CodeLanguagePlainText,
+	CodeLanguagePowerShell,
+	CodeLanguageProlog,
+	CodeLanguageProtobuf,
+	CodeLanguagePureScript,
+	CodeLanguagePython,
+	CodeLanguageR,
+	CodeLanguageRacket,
+	CodeLanguageReason,
+	CodeLanguageRuby,
+	CodeLanguageRust,
+	CodeLanguageSass,
+	CodeLanguageScala,
+	CodeLanguageScheme,
+	CodeLanguageSCSS,
+	CodeLanguageShell,
+	CodeLanguageSmalltalk,
+	CodeLanguageSolidity,
+	CodeLanguageSQL,
+	CodeLanguageSwift,
+	CodeLanguageTOML,
+	CodeLanguageTypeScript,
+	CodeLanguageVBNet,
+	CodeLanguageVerilog,
+	CodeLanguageVHDL,
+	CodeLanguageVisualBasic,
+	CodeLanguageWebAssembly,
+	CodeLanguageXML,
+	CodeLanguageYAML,
+	CodeLanguageJavaCCPlusPlusCSharp,
+}
+
+// IsValid reports whether Notion accepts the language.
+func (l CodeLanguage) IsValid() bool {
+	for _, known := range CodeLanguages {
+		if l == known {
+			return true
+		}
+	}
+	return false
+}
+
+var codeLanguageAliases = map[string]CodeLanguage{
+	"golang":      CodeLanguageGo,
+	"js":          CodeLanguageJavaScript,
+	"jsx":         CodeLanguageJavaScript,
+	"node":        CodeLanguageJavaScript,
+	"ts":          CodeLanguageTypeScript,
+	"tsx":         CodeLanguageTypeScript,
+	"sh":          CodeLanguageShell,
+	"zsh":         CodeLanguageShell,
+	"console":     CodeLanguageShell,
+	"yml":         CodeLanguageYAML,
+	"cpp":         CodeLanguageCPlusPlus,
+	"cxx":         CodeLanguageCPlusPlus,
+	"cc":          CodeLanguageCPlusPlus,
+	"h":           CodeLanguageC,
+	"cs":          CodeLanguageCSharp,
+	"csharp":      CodeLanguageCSharp,
+	"fs":          CodeLanguageFSharp,
+	"fsharp":      CodeLanguageFSharp,
+	"py":          CodeLanguagePython,
+	"python3":     CodeLanguagePython,
+	"rb":          CodeLanguageRuby,
+	"rs":          CodeLanguageRust,
+	"kt":          CodeLanguageKotlin,
+	"kts":         CodeLanguageKotlin,
+	"objc":        CodeLanguageObjectiveC,
+	"objectivec":  CodeLanguageObjectiveC,
+	"md":          CodeLanguageMarkdown,
+	"tex":         CodeLanguageLaTeX,
+	"ps1":         CodeLanguagePowerShell,
+	"pwsh":        CodeLanguagePowerShell,
+	"dockerfile":  CodeLanguageDocker,
+	"make":        CodeLanguageMakefile,
+	"mk":          CodeLanguageMakefile,
+	"proto":       CodeLanguageProtobuf,
+	"hs":          CodeLanguageHaskell,
+	"ex":          CodeLanguageElixir,
+	"exs":         CodeLanguageElixir,
+	"erl":         CodeLanguageErlang,
+	"clj":         CodeLanguageClojure,
+	"ml":          CodeLanguageOCaml,
+	"pl":          CodeLanguagePerl,
+	"tf":          CodeLanguageHCL,
+	"terraform":   CodeLanguageHCL,
+	"wasm":        CodeLanguageWebAssembly,
+	"wat":         CodeLanguageWebAssembly,
+	"vb":          CodeLanguageVisualBasic,
+	"vbnet":       CodeLanguageVBNet,
+	"text":        CodeLanguagePlainText,
+	"txt":         CodeLanguagePlainText,
+	"plaintext":   CodeLanguagePlainText,
+	"plain":       CodeLanguagePlainText,
+	"htm":         CodeLanguageHTML,
+	"svg":         CodeLanguageXML,
+	"gql":         CodeLanguageGraphQL,
+	"jl":          CodeLanguageJulia,
+	"sol":         CodeLanguageSolidity,
+	"v":           CodeLanguageVerilog,
+	"patch":       CodeLanguageDiff,
+	"feature":     CodeLanguageGherkin,
+	"scm":         CodeLanguageScheme,
+	"rkt":         CodeLanguageRacket,
+	"el":          CodeLanguageLisp,
+	"elisp":       CodeLanguageLisp,
+	"common-lisp": CodeLanguageLisp,
+	"ll":          CodeLanguageLLVMIR,
+	"asm":         CodeLanguageAssembly,
+	"ino":         CodeLanguageArduino,
+}
+
+// NormalizeCodeLanguage maps language, a language name as used by Markdown
+// fences or other tools, to the language Notion expects, e.g. "golang" to
+// "go" or "yml" to "yaml". It returns false if the language is unknown.
+func NormalizeCodeLanguage(language string) (CodeLanguage, bool) {
+	l := strings.ToLower(strings.TrimSpace(language))
+	if CodeLanguage(l).IsValid() {
+		return CodeLanguage(l), true
+	}
+	if alias, ok := codeLanguageAliases[l]; ok {
+		return alias, true
+	}
+	return "", false
+}
+
+var codeLanguageExtensions = map[string]CodeLanguage{
+	"c":       CodeLanguageC,
+	"css":     CodeLanguageCSS,
+	"dart":    CodeLanguageDart,
+	"go":      CodeLanguageGo,
+	"graphql": CodeLanguageGraphQL,
+	"groovy":  CodeLanguageGroovy,
+	"html":    CodeLanguageHTML,
+	"java":    CodeLanguageJava,
+	"json":    CodeLanguageJSON,
+	"less":    CodeLanguageLess,
+	"lua":     CodeLanguageLua,
+	"m":       CodeLanguageObjectiveC,
+	"nix":     CodeLanguageNix,
+	"php":     CodeLanguagePHP,
+	"r":       CodeLanguageR,
+	"sass":    CodeLanguageSass,
+	"scala":   CodeLanguageScala,
+	"scss":    CodeLanguageSCSS,
+	"sql":     CodeLanguageSQL,
+	"swift":   CodeLanguageSwift,
+	"toml":    CodeLanguageTOML,
+	"xml":     CodeLanguageXML,
+	"yaml":    CodeLanguageYAML,
+	"bash":    CodeLanguageBash,
+	"elm":     CodeLanguageElm,
+	"hcl":     CodeLanguageHCL,
+	"mjs":     CodeLanguageJavaScript,
+	"cjs":     CodeLanguageJavaScript,
+	"hpp":     CodeLanguageCPlusPlus,
+	"hh":      CodeLanguageCPlusPlus,
+	"f90":     CodeLanguageFortran,
+	"f":       CodeLanguageFortran,
+	"pas":     CodeLanguagePascal,
+	"pro":     CodeLanguageProlog,
+	"purs":    CodeLanguagePureScript,
+	"re":      CodeLanguageReason,
+	"st":      CodeLanguageSmalltalk,
+	"vhd":     CodeLanguageVHDL,
+	"vhdl":    CodeLanguageVHDL,
+	"coffee":  CodeLanguageCoffeeScript,
+	"ls":      CodeLanguageLiveScript,
+	"idr":     CodeLanguageIdris,
+	"agda":    CodeLanguageAgda,
+	"dhall":   CodeLanguageDhall,
+	"mmd":     CodeLanguageMermaid,
+	"mermaid": CodeLanguageMermaid,
+	"glsl":    CodeLanguageGLSL,
+	"frag":    CodeLanguageGLSL,
+	"vert":    CodeLanguageGLSL,
+	"bas":     CodeLanguageBasic,
+	"nb":      CodeLanguageMathematica,
+	"wl":      CodeLanguageMathematica,
+	"v":       CodeLanguageVerilog,
+	"sv":      CodeLanguageVerilog,
+}
+
+// CodeLanguageForExtension returns the language of files with the given
+// extension, with or without the leading dot, e.g. ".go" or "go". Since
+// "Dockerfile" and "Makefile" have no extension, full file names are
+// accepted as well. It returns false if the extension is unknown.
+func CodeLanguageForExtension(ext string) (CodeLanguage, bool) {
+	name := strings.ToLower(path.Base(ext))
+	switch name {
+	case "dockerfile":
+		return CodeLanguageDocker, true
+	case "makefile", "gnumakefile":
+		return CodeLanguageMakefile, true
+	}
+	if e := path.Ext(name); e != "" {
+		name = e
+	}
+	name = strings.TrimPrefix(name, ".")
+	if l, ok := codeLanguageExtensions[name]; ok {
+		return l, true
+	}
+	if l, ok := codeLanguageAliases[name]; ok {
+		return l, true
+	}
+	return "", false
+}
@@ -0,0 +1,65 @@
+package notionapi_test
+
+import (
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestNormalizeCodeLanguage(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   notionapi.CodeLanguage
+		wantOK bool
+	}{
+		{"go", notionapi.CodeLanguageGo, true},
+		{"golang", notionapi.CodeLanguageGo, true},
+		{" JS ", notionapi.CodeLanguageJavaScript, true},
+		{"sh", notionapi.CodeLanguageShell, true},
+		{"yml", notionapi.CodeLanguageYAML, true},
+		{"c++", notionapi.CodeLanguageCPlusPlus, true},
+		{"cpp", notionapi.CodeLanguageCPlusPlus, true},
+		{"Plain Text", notionapi.CodeLanguagePlainText, true},
+		{"brainfuck", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := notionapi.NormalizeCodeLanguage(tt.in)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("NormalizeCodeLanguage(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCodeLanguageForExtension(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   notionapi.CodeLanguage
+		wantOK bool
+	}{
+		{".go", notionapi.CodeLanguageGo, true},
+		{"ts", notionapi.CodeLanguageTypeScript, true},
+		{"main.rs", notionapi.CodeLanguageRust, true},
+		{"config.yml", notionapi.CodeLanguageYAML, true},
+		{"path/to/Dockerfile", notionapi.CodeLanguageDocker, true},
+		{"Makefile", notionapi.CodeLanguageMakefile, true},
+		{".unknown", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := notionapi.CodeLanguageForExtension(tt.in)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("CodeLanguageForExtension(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCodeLanguages(t *testing.T) {
+	for _, l := range notionapi.CodeLanguages {
+		if got, ok := notionapi.NormalizeCodeLanguage(l.String()); !ok || got != l {
+			t.Errorf("NormalizeCodeLanguage(%q) = %q, %v", l, got, ok)
+		}
+	}
+}
@@ -1,14 +1,15 @@
 package notionapi
 
 const (
-	ObjectTypeDatabase ObjectType = "database"
-	ObjectTypeBlock    ObjectType = "block"
-	ObjectTypePage     ObjectType = "page"
-	ObjectTypeList     ObjectType = "list"
-	ObjectTypeText     ObjectType = "text"
-	ObjectTypeUser     ObjectType = "user"
-	ObjectTypeError    ObjectType = "error"
-	ObjectTypeComment  ObjectType = "comment"
+	ObjectTypeDatabase     ObjectType = "database"
+	ObjectTypeBlock        ObjectType = "block"
+	ObjectTypePage         ObjectType = "page"
+	ObjectTypeList         ObjectType = "list"
+	ObjectTypePropertyItem ObjectType = "property_item"
+	ObjectTypeText         ObjectType = "text"
+	ObjectTypeUser         ObjectType = "user"
+	ObjectTypeError        ObjectType = "error"
+	ObjectTypeComment      ObjectType = "comment"
 )
 
 const (
@@ -221,6 +222,7 @@ const (
 	BlockTypeEmbed           BlockType = "embed"
 	BlockTypeImage           BlockType = "image"
 	BlockTypeVideo           BlockType = "video"
+	BlockTypeAudio           BlockType = "audio"
 	BlockTypeFile            BlockType = "file"
 	BlockTypePdf             BlockType = "pdf"
 	BlockTypeBookmark        BlockType = "bookmark"
@@ -245,6 +247,7 @@ const (
 const (
 	FileTypeFile     FileType = "file"
 	FileTypeExternal FileType = "external"
+	FileTypeEmoji    FileType = "emoji"
 )
 
 const (
@@ -258,6 +261,9 @@ const (
 	RollupTypeNumber RollupType = "number"
 	RollupTypeDate   RollupType = "date"
 	RollupTypeArray  RollupType = "array"
+	// RollupTypeIncomplete and RollupTypeUnsupported rollups have no result.
+	RollupTypeIncomplete  RollupType = "incomplete"
+	RollupTypeUnsupported RollupType = "unsupported"
 )
 
 const (
@@ -272,6 +278,9 @@ const (
 	MentionTypeUser            MentionType = "user"
 	MentionTypeDate            MentionType = "date"
 	MentionTypeTemplateMention MentionType = "template_mention"
+	MentionTypeLinkPreview     MentionType = "link_preview"
+	MentionTypeLinkMention     MentionType = "link_mention"
+	MentionTypeCustomEmoji     MentionType = "custom_emoji"
 )
 
 const (
@@ -288,3 +297,10 @@ const (
 	VerificationStateVerified   VerificationState = "verified"
 	VerificationStateUnverified VerificationState = "unverified"
 )
+
+const (
+	SchemaChangeRename SchemaChangeKind = "rename"
+	SchemaChangeUpdate SchemaChangeKind = "update"
+	SchemaChangeAdd    SchemaChangeKind = "add"
+	SchemaChangeRemove SchemaChangeKind = "remove"
+)
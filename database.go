@@ -33,6 +33,10 @@ type DatabaseClient struct {
 //
 // See https://developers.notion.com/reference/create-a-database
 func (dc *DatabaseClient) Create(ctx context.Context, requestBody *DatabaseCreateRequest) (*Database, error) {
+	if err := dc.apiClient.prepareRequest(requestBody); err != nil {
+		return nil, err
+	}
+
 	res, err := dc.apiClient.request(ctx, http.MethodPost, "databases", nil, requestBody)
 	if err != nil {
 		return nil, err
@@ -175,6 +179,79 @@ type DatabaseUpdateRequest struct {
 	// property schema objects. If adding a new property, then the key is the name
 	// of the new database property and the value is a property schema object.
 	Properties PropertyConfigs `json:"properties,omitempty"`
+	// An array of rich text objects that represents the description of the
+	// database. If empty, then the description remains unchanged unless
+	// RemoveDescription is set.
+	Description []RichText `json:"description,omitempty"`
+	// An icon for the database. Supported types are external file object or
+	// emoji object.
+	Icon *Icon `json:"icon,omitempty"`
+	// A cover image for the database. Only external file objects are
+	// supported.
+	Cover *Image `json:"cover,omitempty"`
+	// Whether the database is shown inline in its parent page. If nil, it is
+	// not changed.
+	IsInline *bool `json:"is_inline,omitempty"`
+	// Whether the database is archived (deleted). If nil, it is not changed.
+	Archived *bool `json:"archived,omitempty"`
+	// RemoveIcon removes the icon of the database if Icon is nil.
+	RemoveIcon bool `json:"-"`
+	// RemoveCover removes the cover of the database if Cover is nil.
+	RemoveCover bool `json:"-"`
+	// RemoveDescription clears the description of the database if
+	// Description is empty.
+	RemoveDescription bool `json:"-"`
+
+	// RemoveProperties holds the names or IDs of the properties to delete,
+	// along with their values in every page of the database. A removal takes
+	// precedence over a configuration or rename of the same property.
+	RemoveProperties []string `json:"-"`
+	// RenameProperties maps the names or IDs of properties to their new
+	// names. A rename can be combined with a change of the configuration in
+	// Properties under the same key.
+	RenameProperties map[string]string `json:"-"`
+}
+
+// MarshalJSON merges RemoveProperties and RenameProperties into the
+// properties object, as null and {"name": ...} respectively, and sends null
+// for the icon and cover and [] for the description if they are to be
+// removed.
+func (r DatabaseUpdateRequest) MarshalJSON() ([]byte, error) {
+	type alias DatabaseUpdateRequest
+	raw := struct {
+		alias
+		Properties  map[string]any `json:"properties,omitempty"`
+		Description any            `json:"description,omitempty"`
+		Icon        any            `json:"icon,omitempty"`
+		Cover       any            `json:"cover,omitempty"`
+	}{alias(r), nil, emptyIfRemoved(r.Description, r.RemoveDescription), nullIfRemoved(r.Icon, r.RemoveIcon), nullIfRemoved(r.Cover, r.RemoveCover)}
+	n := len(r.Properties) + len(r.RemoveProperties) + len(r.RenameProperties)
+	if n == 0 {
+		return json.Marshal(raw)
+	}
+
+	raw.Properties = make(map[string]any, n)
+	for key, config := range r.Properties {
+		raw.Properties[key] = config
+	}
+	for key, name := range r.RenameProperties {
+		value := map[string]any{}
+		if config, ok := r.Properties[key]; ok && config != nil {
+			data, err := json.Marshal(config)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(data, &value); err != nil {
+				return nil, err
+			}
+		}
+		value["name"] = name
+		raw.Properties[key] = value
+	}
+	for _, key := range r.RemoveProperties {
+		raw.Properties[key] = nil
+	}
+	return json.Marshal(raw)
 }
 
 type Database struct {
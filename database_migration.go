@@ -0,0 +1,427 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type SchemaChangeKind string
+
+// SchemaChange is a change of a single database property, see DiffSchema.
+type SchemaChange struct {
+	Kind SchemaChangeKind
+	// Name is the name of the property before the migration or, for added
+	// properties, its desired name.
+	Name string
+	// NewName is the desired name of a renamed property.
+	NewName string
+	// Old is the live configuration of the property, nil for additions. New
+	// is its desired configuration, nil for removals.
+	Old, New PropertyConfig
+	// Warnings describe data lost by the change, like the values of a removed
+	// property or the options dropped from a select.
+	Warnings []string
+}
+
+func (c SchemaChange) String() string {
+	switch c.Kind {
+	case SchemaChangeRename:
+		return fmt.Sprintf("rename %q to %q", c.Name, c.NewName)
+	case SchemaChangeAdd:
+		return fmt.Sprintf("add %q (%s)", c.Name, c.New.GetType())
+	case SchemaChangeRemove:
+		return fmt.Sprintf("remove %q (%s)", c.Name, c.Old.GetType())
+	}
+	if c.Old.GetType() != c.New.GetType() {
+		return fmt.Sprintf("change type of %q from %s to %s", c.Name, c.Old.GetType(), c.New.GetType())
+	}
+	oldOptions, _ := configOptions(c.Old)
+	newOptions, ok := configOptions(c.New)
+	if !ok {
+		return fmt.Sprintf("change configuration of %q (%s)", c.Name, c.New.GetType())
+	}
+	var details []string
+	for _, o := range newOptions {
+		match := matchOption(oldOptions, o)
+		switch {
+		case match == nil:
+			details = append(details, fmt.Sprintf("add option %q", o.Name))
+		case match.Name != o.Name:
+			details = append(details, fmt.Sprintf("rename option %q to %q", match.Name, o.Name))
+		case o.Color != "" && match.Color != o.Color:
+			details = append(details, fmt.Sprintf("change color of option %q to %s", o.Name, o.Color))
+		}
+	}
+	for _, o := range droppedOptions(oldOptions, newOptions) {
+		details = append(details, fmt.Sprintf("drop option %q", o.Name))
+	}
+	return fmt.Sprintf("change options of %q: %s", c.Name, strings.Join(details, ", "))
+}
+
+// SchemaMigration turns the properties of a database into a desired schema.
+type SchemaMigration struct {
+	Changes []SchemaChange
+	// Steps are the requests applying the changes, in this order: renames,
+	// changes of types and options, additions and removals. Steps without
+	// changes are left out.
+	Steps []*DatabaseUpdateRequest
+}
+
+// DiffSchema returns the migration turning the live properties of a
+// database into the desired ones, e.g. the Properties of a Database returned
+// by DatabaseClient.Get and a schema kept in version control.
+//
+// Desired properties are matched to live ones by ID if they have one, so a
+// property whose name differs from its live name is renamed, and by name
+// otherwise. The title property is matched to the live title property if
+// neither is matched otherwise, as a database has exactly one. Unmatched
+// desired properties are added and unmatched live properties are removed.
+//
+// Options of select and multi_select properties are matched the same way,
+// by ID or name, and keep their live ID and color unless the desired option
+// sets them. Other configurations are compared by their JSON encoding
+// without IDs, where fields left empty in the desired configuration match
+// the defaults Notion fills in, like the number format. Status options
+// cannot be changed through the API and are not compared.
+//
+// DiffSchema fails if a property would be renamed to the name of another
+// live property, as the rename would collide with it.
+func DiffSchema(live, desired PropertyConfigs) (*SchemaMigration, error) {
+	// matches maps desired names to the names of the matched live
+	// properties.
+	matches := map[string]string{}
+	matched := map[string]bool{}
+	match := func(desiredName, liveName string) {
+		matches[desiredName] = liveName
+		matched[liveName] = true
+	}
+
+	liveByID := map[PropertyID]string{}
+	for name, config := range live {
+		if config != nil && config.GetID() != "" {
+			liveByID[config.GetID()] = name
+		}
+	}
+	desiredNames := sortedConfigNames(desired)
+	for _, name := range desiredNames {
+		if id := desired[name].GetID(); id != "" {
+			if liveName, ok := liveByID[id]; ok {
+				match(name, liveName)
+			}
+		}
+	}
+	for _, name := range desiredNames {
+		if _, ok := matches[name]; ok {
+			continue
+		}
+		if config, ok := live[name]; ok && config != nil && !matched[name] {
+			match(name, name)
+		}
+	}
+	if desiredTitle, liveTitle := titleConfigName(desired), titleConfigName(live); desiredTitle != "" && liveTitle != "" {
+		if _, ok := matches[desiredTitle]; !ok && !matched[liveTitle] {
+			match(desiredTitle, liveTitle)
+		}
+	}
+
+	m := &SchemaMigration{}
+	renames, updates, additions, removals := &DatabaseUpdateRequest{}, &DatabaseUpdateRequest{}, &DatabaseUpdateRequest{}, &DatabaseUpdateRequest{}
+
+	for _, name := range desiredNames {
+		liveName, ok := matches[name]
+		if !ok || liveName == name {
+			continue
+		}
+		if _, taken := live[name]; taken {
+			return nil, fmt.Errorf("cannot rename property %q to %q: the database has another property with that name", liveName, name)
+		}
+		m.Changes = append(m.Changes, SchemaChange{
+			Kind: SchemaChangeRename, Name: liveName, NewName: name, Old: live[liveName], New: desired[name],
+		})
+		if renames.RenameProperties == nil {
+			renames.RenameProperties = map[string]string{}
+		}
+		renames.RenameProperties[configKey(live[liveName], liveName)] = name
+	}
+
+	for _, name := range desiredNames {
+		liveName, ok := matches[name]
+		if !ok {
+			continue
+		}
+		old, config := live[liveName], desired[name]
+		config, warnings, changed, err := diffConfig(liveName, old, config)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			continue
+		}
+		m.Changes = append(m.Changes, SchemaChange{
+			Kind: SchemaChangeUpdate, Name: liveName, Old: old, New: config, Warnings: warnings,
+		})
+		if updates.Properties == nil {
+			updates.Properties = PropertyConfigs{}
+		}
+		updates.Properties[configKey(old, name)] = config
+	}
+
+	for _, name := range desiredNames {
+		if _, ok := matches[name]; ok {
+			continue
+		}
+		m.Changes = append(m.Changes, SchemaChange{Kind: SchemaChangeAdd, Name: name, New: desired[name]})
+		if additions.Properties == nil {
+			additions.Properties = PropertyConfigs{}
+		}
+		additions.Properties[name] = desired[name]
+	}
+
+	for _, name := range sortedConfigNames(live) {
+		if matched[name] {
+			continue
+		}
+		m.Changes = append(m.Changes, SchemaChange{
+			Kind: SchemaChangeRemove, Name: name, Old: live[name],
+			Warnings: []string{fmt.Sprintf("removing %q deletes its values", name)},
+		})
+		removals.RemoveProperties = append(removals.RemoveProperties, configKey(live[name], name))
+	}
+
+	for _, step := range []*DatabaseUpdateRequest{renames, updates, additions, removals} {
+		if len(step.Properties)+len(step.RenameProperties)+len(step.RemoveProperties) > 0 {
+			m.Steps = append(m.Steps, step)
+		}
+	}
+	return m, nil
+}
+
+// Warnings returns the warnings of all changes.
+func (m *SchemaMigration) Warnings() []string {
+	var warnings []string
+	for _, c := range m.Changes {
+		warnings = append(warnings, c.Warnings...)
+	}
+	return warnings
+}
+
+// Destructive reports whether the migration can lose data.
+func (m *SchemaMigration) Destructive() bool {
+	return len(m.Warnings()) > 0
+}
+
+// Report describes the migration without applying it, one change per line
+// followed by the warnings.
+func (m *SchemaMigration) Report() string {
+	if len(m.Changes) == 0 {
+		return "no changes\n"
+	}
+	var b strings.Builder
+	for _, c := range m.Changes {
+		fmt.Fprintf(&b, "%s\n", c)
+	}
+	for _, w := range m.Warnings() {
+		fmt.Fprintf(&b, "warning: %s\n", w)
+	}
+	return b.String()
+}
+
+// Apply applies the steps of the migration to the database with the given ID
+// and returns the database after the last step. Steps are not rolled back if
+// a later one fails. Without steps, Apply returns the database unchanged.
+func (m *SchemaMigration) Apply(ctx context.Context, databases DatabaseService, id DatabaseID) (*Database, error) {
+	if len(m.Steps) == 0 {
+		return databases.Get(ctx, id)
+	}
+	var db *Database
+	for i, step := range m.Steps {
+		var err error
+		if db, err = databases.Update(ctx, id, step); err != nil {
+			return nil, fmt.Errorf("migration step %d of %d: %w", i+1, len(m.Steps), err)
+		}
+	}
+	return db, nil
+}
+
+// diffConfig compares the live configuration of a property with the desired
+// one. It returns the configuration to send, which keeps the IDs and colors
+// of live options, and whether it differs from the live one.
+func diffConfig(name string, old, config PropertyConfig) (PropertyConfig, []string, bool, error) {
+	if old.GetType() != config.GetType() {
+		warning := fmt.Sprintf("changing the type of %q from %s to %s may lose its values", name, old.GetType(), config.GetType())
+		return config, []string{warning}, true, nil
+	}
+	if config.GetType() == PropertyConfigStatus {
+		return config, nil, false, nil
+	}
+
+	if options, ok := configOptions(config); ok {
+		oldOptions, _ := configOptions(old)
+		changed := len(options) != len(oldOptions)
+		resolved := make([]Option, len(options))
+		for i, o := range options {
+			resolved[i] = o
+			match := matchOption(oldOptions, o)
+			if match == nil {
+				changed = true
+				continue
+			}
+			resolved[i].ID = match.ID
+			if o.Color == "" {
+				resolved[i].Color = match.Color
+			}
+			changed = changed || resolved[i] != *match
+		}
+		var warnings []string
+		for _, o := range droppedOptions(oldOptions, options) {
+			warnings = append(warnings, fmt.Sprintf("dropping option %q of %q removes it from the rows using it", o.Name, name))
+		}
+		return withOptions(config, resolved), warnings, changed, nil
+	}
+
+	a, err := configJSON(old)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	b, err := configJSON(config)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return config, nil, !hasConfigValues(a, b), nil
+}
+
+// hasConfigValues reports whether live holds every value set in desired.
+// Empty desired values are skipped, since Notion fills them in with defaults,
+// like the format of a number or the synced property of a relation.
+func hasConfigValues(live, desired map[string]any) bool {
+	for k, v := range desired {
+		switch v := v.(type) {
+		case nil:
+			continue
+		case string:
+			if v == "" {
+				continue
+			}
+		case map[string]any:
+			l, _ := live[k].(map[string]any)
+			if !hasConfigValues(l, v) {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(live[k], v) {
+			return false
+		}
+	}
+	return true
+}
+
+// configJSON returns the JSON encoding of config without its ID.
+func configJSON(config PropertyConfig) (map[string]any, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	delete(raw, "id")
+	return raw, nil
+}
+
+// configOptions returns the options of a select or multi_select
+// configuration.
+func configOptions(config PropertyConfig) ([]Option, bool) {
+	switch c := config.(type) {
+	case *SelectPropertyConfig:
+		return c.Select.Options, true
+	case SelectPropertyConfig:
+		return c.Select.Options, true
+	case *MultiSelectPropertyConfig:
+		return c.MultiSelect.Options, true
+	case MultiSelectPropertyConfig:
+		return c.MultiSelect.Options, true
+	}
+	return nil, false
+}
+
+// withOptions returns a copy of a select or multi_select configuration with
+// the given options.
+func withOptions(config PropertyConfig, options []Option) PropertyConfig {
+	switch c := config.(type) {
+	case *SelectPropertyConfig:
+		return &SelectPropertyConfig{ID: c.ID, Type: c.Type, Select: Select{Options: options}}
+	case SelectPropertyConfig:
+		return &SelectPropertyConfig{ID: c.ID, Type: c.Type, Select: Select{Options: options}}
+	case *MultiSelectPropertyConfig:
+		return &MultiSelectPropertyConfig{ID: c.ID, Type: c.Type, MultiSelect: Select{Options: options}}
+	case MultiSelectPropertyConfig:
+		return &MultiSelectPropertyConfig{ID: c.ID, Type: c.Type, MultiSelect: Select{Options: options}}
+	}
+	return config
+}
+
+// matchOption returns the option of options with the ID of o or, if o has
+// no ID, with its name.
+func matchOption(options []Option, o Option) *Option {
+	for i := range options {
+		if (o.ID != "" && options[i].ID == o.ID) || (o.ID == "" && options[i].Name == o.Name) {
+			return &options[i]
+		}
+	}
+	return nil
+}
+
+// droppedOptions returns the options of old not matched by any option of
+// desired.
+func droppedOptions(old, desired []Option) []Option {
+	var dropped []Option
+	for _, o := range old {
+		found := false
+		for _, d := range desired {
+			if matchOption([]Option{o}, d) != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dropped = append(dropped, o)
+		}
+	}
+	return dropped
+}
+
+// configKey returns the key addressing a live property in an update
+// request: its ID, or name if it has none.
+func configKey(config PropertyConfig, name string) string {
+	if config != nil && config.GetID() != "" {
+		return config.GetID().String()
+	}
+	return name
+}
+
+func titleConfigName(configs PropertyConfigs) string {
+	for name, config := range configs {
+		if config != nil && config.GetType() == PropertyConfigTypeTitle {
+			return name
+		}
+	}
+	return ""
+}
+
+// sortedConfigNames returns the names of the non-nil configurations, sorted.
+func sortedConfigNames(configs PropertyConfigs) []string {
+	names := make([]string, 0, len(configs))
+	for name, config := range configs {
+		if config != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
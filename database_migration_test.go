@@ -0,0 +1,224 @@
+package notionapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+const liveSchema = `{
+  "Name": {"id": "title", "type": "title", "title": {}},
+  "Status": {"id": "s1", "type": "select", "select": {"options": [
+    {"id": "o1", "name": "Todo", "color": "red"},
+    {"id": "o2", "name": "Done", "color": "green"}
+  ]}},
+  "Old Notes": {"id": "n1", "type": "rich_text", "rich_text": {}},
+  "Points": {"id": "p1", "type": "number", "number": {"format": "number"}},
+  "Count": {"id": "k1", "type": "rich_text", "rich_text": {}},
+  "Tags": {"id": "t1", "type": "multi_select", "multi_select": {"options": [{"id": "a", "name": "go", "color": "blue"}]}},
+  "Obsolete": {"id": "c1", "type": "checkbox", "checkbox": {}}
+}`
+
+const desiredSchema = `{
+  "Title": {"type": "title", "title": {}},
+  "Status": {"type": "select", "select": {"options": [
+    {"name": "Todo"},
+    {"name": "In progress", "color": "blue"}
+  ]}},
+  "Notes": {"id": "n1", "type": "rich_text", "rich_text": {}},
+  "Points": {"type": "number", "number": {"format": "dollar"}},
+  "Count": {"type": "number", "number": {"format": "number"}},
+  "Tags": {"type": "multi_select", "multi_select": {"options": [{"id": "a", "name": "golang"}]}},
+  "Due": {"type": "date", "date": {}}
+}`
+
+func parseSchema(t *testing.T, schema string) notionapi.PropertyConfigs {
+	t.Helper()
+	var configs notionapi.PropertyConfigs
+	if err := json.Unmarshal([]byte(schema), &configs); err != nil {
+		t.Fatal(err)
+	}
+	return configs
+}
+
+func TestDiffSchema(t *testing.T) {
+	live, desired := parseSchema(t, liveSchema), parseSchema(t, desiredSchema)
+	m, err := notionapi.DiffSchema(live, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantReport := `rename "Old Notes" to "Notes"
+rename "Name" to "Title"
+change type of "Count" from rich_text to number
+change configuration of "Points" (number)
+change options of "Status": add option "In progress", drop option "Done"
+change options of "Tags": rename option "go" to "golang"
+add "Due" (date)
+remove "Obsolete" (checkbox)
+warning: changing the type of "Count" from rich_text to number may lose its values
+warning: dropping option "Done" of "Status" removes it from the rows using it
+warning: removing "Obsolete" deletes its values
+`
+	if got := m.Report(); got != wantReport {
+		t.Errorf("Report() =\n%s\nwant\n%s", got, wantReport)
+	}
+	if !m.Destructive() {
+		t.Error("Destructive() = false, want true")
+	}
+
+	if len(m.Steps) != 4 {
+		t.Fatalf("got %d steps, want 4", len(m.Steps))
+	}
+	var steps []map[string]any
+	for _, step := range m.Steps {
+		var raw map[string]map[string]any
+		if err := json.Unmarshal([]byte(mustJSON(t, step)), &raw); err != nil {
+			t.Fatal(err)
+		}
+		steps = append(steps, raw["properties"])
+	}
+
+	wantRenames := map[string]any{"n1": map[string]any{"name": "Notes"}, "title": map[string]any{"name": "Title"}}
+	if !reflect.DeepEqual(steps[0], wantRenames) {
+		t.Errorf("renames = %v, want %v", steps[0], wantRenames)
+	}
+	if got := keys(steps[1]); !reflect.DeepEqual(got, []string{"k1", "p1", "s1", "t1"}) {
+		t.Errorf("updated properties = %v", got)
+	}
+	wantOptions := []any{
+		map[string]any{"id": "o1", "name": "Todo", "color": "red"},
+		map[string]any{"name": "In progress", "color": "blue"},
+	}
+	if got := steps[1]["s1"].(map[string]any)["select"].(map[string]any)["options"]; !reflect.DeepEqual(got, wantOptions) {
+		t.Errorf("status options = %v, want %v", got, wantOptions)
+	}
+	wantTags := []any{map[string]any{"id": "a", "name": "golang", "color": "blue"}}
+	if got := steps[1]["t1"].(map[string]any)["multi_select"].(map[string]any)["options"]; !reflect.DeepEqual(got, wantTags) {
+		t.Errorf("tags options = %v, want %v", got, wantTags)
+	}
+	if got := keys(steps[2]); !reflect.DeepEqual(got, []string{"Due"}) {
+		t.Errorf("added properties = %v", got)
+	}
+	if want := map[string]any{"c1": nil}; !reflect.DeepEqual(steps[3], want) {
+		t.Errorf("removals = %v, want %v", steps[3], want)
+	}
+}
+
+func TestDiffSchemaUnchanged(t *testing.T) {
+	live := parseSchema(t, liveSchema)
+	m, err := notionapi.DiffSchema(live, parseSchema(t, liveSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Changes) != 0 || len(m.Steps) != 0 || m.Report() != "no changes\n" {
+		t.Errorf("DiffSchema() of the same schema = %v, report %q", m.Changes, m.Report())
+	}
+}
+
+func TestDiffSchemaRenameCollision(t *testing.T) {
+	live := parseSchema(t, `{
+  "Name": {"id": "title", "type": "title", "title": {}},
+  "A": {"id": "a", "type": "rich_text", "rich_text": {}},
+  "B": {"id": "b", "type": "rich_text", "rich_text": {}}
+}`)
+	desired := parseSchema(t, `{
+  "Name": {"type": "title", "title": {}},
+  "B": {"id": "a", "type": "rich_text", "rich_text": {}}
+}`)
+	if _, err := notionapi.DiffSchema(live, desired); err == nil {
+		t.Error("DiffSchema() renaming onto an existing property did not fail")
+	}
+}
+
+func TestDiffSchemaServerDefaults(t *testing.T) {
+	live := parseSchema(t, `{
+  "Name": {"id": "title", "type": "title", "title": {}},
+  "Points": {"id": "p1", "type": "number", "number": {"format": "number"}},
+  "Blocked by": {"id": "r1", "type": "relation", "relation": {
+    "database_id": "db", "type": "dual_property", "dual_property": {},
+    "synced_property_id": "r2", "synced_property_name": "Blocking"
+  }}
+}`)
+	desired := parseSchema(t, `{
+  "Name": {"type": "title", "title": {}},
+  "Points": {"type": "number", "number": {}},
+  "Depends on": {"id": "r1", "type": "relation", "relation": {"database_id": "db", "type": "dual_property", "dual_property": {}}}
+}`)
+	m, err := notionapi.DiffSchema(live, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "rename \"Blocked by\" to \"Depends on\"\n"; m.Report() != want {
+		t.Errorf("Report() =\n%s\nwant\n%s", m.Report(), want)
+	}
+
+	desired = parseSchema(t, `{
+  "Name": {"type": "title", "title": {}},
+  "Points": {"type": "number", "number": {"format": "percent"}},
+  "Blocked by": {"type": "relation", "relation": {"database_id": "other", "type": "dual_property", "dual_property": {}}}
+}`)
+	if m, err = notionapi.DiffSchema(live, desired); err != nil {
+		t.Fatal(err)
+	}
+	want := `change configuration of "Blocked by" (relation)
+change configuration of "Points" (number)
+`
+	if m.Report() != want {
+		t.Errorf("Report() =\n%s\nwant\n%s", m.Report(), want)
+	}
+}
+
+func TestSchemaMigrationApply(t *testing.T) {
+	fake := newFakeNotion(t)
+	fake.addDatabase("db", `{"title": [], "properties": `+liveSchema+`}`)
+	client := fake.client()
+
+	desired := parseSchema(t, desiredSchema)
+	m, err := notionapi.DiffSchema(parseSchema(t, liveSchema), desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := m.Apply(context.Background(), client.Database, "db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantRequests := []string{"PATCH databases/db", "PATCH databases/db", "PATCH databases/db", "PATCH databases/db"}
+	if !reflect.DeepEqual(fake.requests, wantRequests) {
+		t.Errorf("requests = %v, want %v", fake.requests, wantRequests)
+	}
+	if got, want := keys(db.Properties), keys(desired); !reflect.DeepEqual(got, want) {
+		t.Errorf("properties = %v, want %v", got, want)
+	}
+	for name, config := range desired {
+		if got := db.Properties[name].GetType(); got != config.GetType() {
+			t.Errorf("type of %s = %s, want %s", name, got, config.GetType())
+		}
+	}
+	if id := db.Properties["Notes"].GetID(); id != "n1" {
+		t.Errorf("ID of renamed property = %s, want n1", id)
+	}
+
+	// The migrated database needs no further changes.
+	again, err := notionapi.DiffSchema(db.Properties, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Steps) != 0 {
+		t.Errorf("second migration:\n%s", again.Report())
+	}
+}
+
+func keys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,199 @@
+package notionapi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DatabaseTable reads and writes the rows of a database as values of T, a
+// struct type mapped to the properties of the database with notion tags, see
+// MarshalProperties.
+type DatabaseTable[T any] struct {
+	ID DatabaseID
+	// Key is the name of the property identifying rows for Upsert. It has to
+	// be a tagged field of T of type title, rich_text, url, email,
+	// phone_number, number, select, status or unique_id.
+	Key string
+
+	databases DatabaseService
+	pages     PageService
+}
+
+// Row is a database row read into a value of T.
+type Row[T any] struct {
+	ID    PageID
+	Value T
+	// Page is the page the row was read from.
+	Page *Page
+}
+
+// NewDatabaseTable returns a DatabaseTable for the database with the given ID
+// using the services of client.
+func NewDatabaseTable[T any](client *Client, id DatabaseID) *DatabaseTable[T] {
+	return &DatabaseTable[T]{ID: id, databases: client.Database, pages: client.Page}
+}
+
+// List returns all rows of the database matching filter, in the order given
+// by sorts. Both can be nil.
+func (t *DatabaseTable[T]) List(ctx context.Context, filter Filter, sorts []SortObject) ([]*Row[T], error) {
+	var rows []*Row[T]
+	query := &DatabaseQueryRequest{Filter: filter, Sorts: sorts, PageSize: MaxArrayLength}
+	for {
+		res, err := t.databases.Query(ctx, t.ID, query)
+		if err != nil {
+			return nil, err
+		}
+		for i := range res.Results {
+			row, err := t.row(&res.Results[i])
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+		if !res.HasMore || res.NextCursor == "" {
+			return rows, nil
+		}
+		query.StartCursor = res.NextCursor
+	}
+}
+
+// Get returns the row with the given ID. It fails if the page is not part
+// of the database.
+func (t *DatabaseTable[T]) Get(ctx context.Context, id PageID) (*Row[T], error) {
+	page, err := t.pages.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !sameID(string(page.Parent.DatabaseID), string(t.ID)) {
+		return nil, fmt.Errorf("page %s is not part of database %s", id, t.ID)
+	}
+	return t.row(page)
+}
+
+// Insert creates a row holding v.
+func (t *DatabaseTable[T]) Insert(ctx context.Context, v T) (*Row[T], error) {
+	properties, err := MarshalProperties(v)
+	if err != nil {
+		return nil, err
+	}
+	page, err := t.pages.Create(ctx, &PageCreateRequest{
+		Parent:     Parent{Type: ParentTypeDatabaseID, DatabaseID: t.ID},
+		Properties: properties,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.row(page)
+}
+
+// Update sets the properties of the row with the given ID to the values of
+// v.
+func (t *DatabaseTable[T]) Update(ctx context.Context, id PageID, v T) (*Row[T], error) {
+	properties, err := MarshalProperties(v)
+	if err != nil {
+		return nil, err
+	}
+	page, err := t.pages.Update(ctx, id, &PageUpdateRequest{Properties: properties})
+	if err != nil {
+		return nil, err
+	}
+	return t.row(page)
+}
+
+// Upsert updates the row whose Key property equals key with the values of v
+// or inserts v if there is no such row. It fails if several rows match.
+func (t *DatabaseTable[T]) Upsert(ctx context.Context, key string, v T) (*Row[T], error) {
+	filter, err := t.keyFilter(key)
+	if err != nil {
+		return nil, err
+	}
+	res, err := t.databases.Query(ctx, t.ID, &DatabaseQueryRequest{Filter: filter, PageSize: 2})
+	if err != nil {
+		return nil, err
+	}
+	switch len(res.Results) {
+	case 0:
+		return t.Insert(ctx, v)
+	case 1:
+		return t.Update(ctx, PageID(res.Results[0].ID), v)
+	}
+	return nil, fmt.Errorf("several rows of database %s have %s %q", t.ID, t.Key, key)
+}
+
+// Archive archives the row with the given ID.
+func (t *DatabaseTable[T]) Archive(ctx context.Context, id PageID) error {
+	archived := true
+	_, err := t.pages.Update(ctx, id, &PageUpdateRequest{Archived: &archived})
+	return err
+}
+
+func (t *DatabaseTable[T]) row(page *Page) (*Row[T], error) {
+	row := &Row[T]{ID: PageID(page.ID), Page: page}
+	if err := UnmarshalPage(page, &row.Value); err != nil {
+		return nil, fmt.Errorf("page %s: %w", page.ID, err)
+	}
+	return row, nil
+}
+
+// keyFilter returns the filter matching the rows whose Key property equals
+// key, using the filter condition of the type of the Key property.
+func (t *DatabaseTable[T]) keyFilter(key string) (Filter, error) {
+	if t.Key == "" {
+		return nil, fmt.Errorf("database table %s has no key property", t.ID)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("empty key for key property %s", t.Key)
+	}
+	var zero T
+	fields, err := propertyFields(reflect.TypeOf(zero))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if f.name != t.Key {
+			continue
+		}
+		filter := PropertyFilter{Property: t.Key}
+		switch f.typ {
+		case PropertyTypeTitle:
+			filter.Title = &TextFilterCondition{Equals: key}
+		case PropertyTypeRichText, PropertyTypeText:
+			filter.RichText = &TextFilterCondition{Equals: key}
+		case PropertyTypeURL:
+			filter.URL = &TextFilterCondition{Equals: key}
+		case PropertyTypeEmail:
+			filter.Email = &TextFilterCondition{Equals: key}
+		case PropertyTypePhoneNumber:
+			filter.PhoneNumber = &TextFilterCondition{Equals: key}
+		case PropertyTypeSelect:
+			filter.Select = &SelectFilterCondition{Equals: key}
+		case PropertyTypeStatus:
+			filter.Status = &StatusFilterCondition{Equals: key}
+		case PropertyTypeNumber:
+			n, err := strconv.ParseFloat(key, 64)
+			if err != nil {
+				return nil, fmt.Errorf("key %q of number property %s: %w", key, t.Key, err)
+			}
+			filter.Number = &NumberFilterCondition{Equals: &n}
+		case PropertyTypeUniqueID:
+			// Unique IDs are filtered by their number, without the prefix.
+			n, err := strconv.Atoi(key[strings.LastIndex(key, "-")+1:])
+			if err != nil {
+				return nil, fmt.Errorf("key %q of unique_id property %s: %w", key, t.Key, err)
+			}
+			filter.UniqueId = &UniqueIdFilterCondition{Equals: &n}
+		default:
+			return nil, fmt.Errorf("key property %s of type %s is not supported", t.Key, f.typ)
+		}
+		return filter, nil
+	}
+	return nil, fmt.Errorf("key property %s is not a field of %T", t.Key, zero)
+}
+
+// sameID reports whether a and b are the same Notion ID, ignoring dashes.
+func sameID(a, b string) bool {
+	return strings.ReplaceAll(a, "-", "") == strings.ReplaceAll(b, "-", "")
+}
@@ -0,0 +1,114 @@
+package notionapi_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+type task struct {
+	Name   string   `notion:"Name,title"`
+	Points float64  `notion:"Points"`
+	Tags   []string `notion:"Tags,multi_select"`
+}
+
+func TestDatabaseTable(t *testing.T) {
+	ctx := context.Background()
+	f := newFakeNotion(t)
+	f.addDatabase("db", `{"properties":{}}`)
+	table := notionapi.NewDatabaseTable[task](f.client(), "db")
+	table.Key = "Name"
+
+	first, err := table.Insert(ctx, task{Name: "first", Points: 1, Tags: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := table.Insert(ctx, task{Name: "second", Points: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := table.Get(ctx, first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (task{Name: "first", Points: 1, Tags: []string{"a"}}); !reflect.DeepEqual(got.Value, want) {
+		t.Errorf("Get() = %+v, want %+v", got.Value, want)
+	}
+
+	if _, err := table.Update(ctx, first.ID, task{Name: "first", Points: 5, Tags: []string{}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := table.Upsert(ctx, "second", task{Name: "second", Points: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := table.Upsert(ctx, "third", task{Name: "third", Points: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.Archive(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := table.List(ctx, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values []task
+	for _, row := range rows {
+		values = append(values, row.Value)
+	}
+	want := []task{{Name: "second", Points: 3, Tags: []string{}}, {Name: "third", Points: 4, Tags: []string{}}}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("List() = %+v, want %+v", values, want)
+	}
+	if n := len(f.rows["db"]); n != 2 {
+		t.Errorf("database has %d rows, want 2", n)
+	}
+}
+
+func TestDatabaseTableUpsertKeyTypes(t *testing.T) {
+	type link struct {
+		Name string `notion:"Name,title"`
+		URL  string `notion:"URL,url"`
+	}
+	ctx := context.Background()
+	f := newFakeNotion(t)
+	f.addDatabase("db", `{"properties":{}}`)
+	table := notionapi.NewDatabaseTable[link](f.client(), "db")
+	table.Key = "URL"
+
+	for _, v := range []link{{Name: "a", URL: "https://a.com"}, {Name: "b", URL: "https://b.com"}} {
+		if _, err := table.Insert(ctx, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := table.Upsert(ctx, "https://b.com", link{Name: "B", URL: "https://b.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := table.Upsert(ctx, "", link{Name: "c"}); err == nil {
+		t.Error("Upsert() with an empty key succeeded")
+	}
+
+	rows, err := table.List(ctx, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values []link
+	for _, row := range rows {
+		values = append(values, row.Value)
+	}
+	want := []link{{Name: "a", URL: "https://a.com"}, {Name: "B", URL: "https://b.com"}}
+	if !reflect.DeepEqual(values, want) {
+		t.Errorf("List() = %+v, want %+v", values, want)
+	}
+}
+
+func TestDatabaseTableGetOtherDatabase(t *testing.T) {
+	f := newFakeNotion(t)
+	f.addPage("p", `{"parent":{"type":"database_id","database_id":"other"},"properties":{}}`)
+	table := notionapi.NewDatabaseTable[task](f.client(), "db")
+	if _, err := table.Get(context.Background(), "p"); err == nil {
+		t.Error("Get() of a page of another database succeeded")
+	}
+}
@@ -2,6 +2,7 @@ package notionapi_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"reflect"
 	"testing"
@@ -568,3 +569,82 @@ func TestDatabaseQueryRequest_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseUpdateRequest_MarshalJSON(t *testing.T) {
+	emoji := notionapi.Emoji("📚")
+	inline, archived := true, false
+	tests := []struct {
+		name string
+		req  *notionapi.DatabaseUpdateRequest
+		want string
+	}{
+		{
+			name: "remove property",
+			req:  &notionapi.DatabaseUpdateRequest{RemoveProperties: []string{"Old"}},
+			want: `{"properties":{"Old":null}}`,
+		},
+		{
+			name: "rename property",
+			req:  &notionapi.DatabaseUpdateRequest{RenameProperties: map[string]string{"abcd": "New"}},
+			want: `{"properties":{"abcd":{"name":"New"}}}`,
+		},
+		{
+			name: "rename and change property",
+			req: &notionapi.DatabaseUpdateRequest{
+				Properties: notionapi.PropertyConfigs{
+					"Old": notionapi.NumberPropertyConfig{
+						Type:   notionapi.PropertyConfigTypeNumber,
+						Number: notionapi.NumberFormat{Format: notionapi.FormatDollar},
+					},
+					"Other": notionapi.CheckboxPropertyConfig{Type: notionapi.PropertyConfigTypeCheckbox},
+				},
+				RenameProperties: map[string]string{"Old": "New"},
+				RemoveProperties: []string{"Gone"},
+			},
+			want: `{"properties":{"Gone":null,"Old":{"name":"New","number":{"format":"dollar"},"type":"number"},"Other":{"type":"checkbox","checkbox":{}}}}`,
+		},
+		{
+			name: "remove takes precedence",
+			req: &notionapi.DatabaseUpdateRequest{
+				RenameProperties: map[string]string{"Old": "New"},
+				RemoveProperties: []string{"Old"},
+			},
+			want: `{"properties":{"Old":null}}`,
+		},
+		{
+			name: "description, icon, cover, is_inline and archived",
+			req: &notionapi.DatabaseUpdateRequest{
+				Description: []notionapi.RichText{{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: "Books"}}},
+				Icon:        &notionapi.Icon{Type: "emoji", Emoji: &emoji},
+				Cover: &notionapi.Image{
+					Type:     "external",
+					External: &notionapi.FileObject{URL: "https://website.domain/images/image.png"},
+				},
+				IsInline: &inline,
+				Archived: &archived,
+			},
+			want: `{"is_inline":true,"archived":false,"description":[{"type":"text","text":{"content":"Books"}}],"icon":{"type":"emoji","emoji":"📚"},"cover":{"type":"external","external":{"url":"https://website.domain/images/image.png"}}}`,
+		},
+		{
+			name: "remove icon and cover",
+			req:  &notionapi.DatabaseUpdateRequest{RemoveIcon: true, RemoveCover: true},
+			want: `{"icon":null,"cover":null}`,
+		},
+		{
+			name: "remove description",
+			req:  &notionapi.DatabaseUpdateRequest{RemoveDescription: true},
+			want: `{"description":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
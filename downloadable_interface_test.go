@@ -74,6 +74,52 @@ func TestImageBlockImplementsDownloadableFileBlock(t *testing.T) {
 	}
 }
 
+func TestAudioBlockImplementsDownloadableFileBlock(t *testing.T) {
+	// Test setup
+	now := time.Now()
+	audioBlock := &AudioBlock{
+		Audio: Audio{
+			File: &FileObject{
+				URL:        "https://example.com/song.mp3",
+				ExpiryTime: &now,
+			},
+		},
+	}
+
+	// Test GetURL
+	if url := audioBlock.GetURL(); url != "https://example.com/song.mp3" {
+		t.Errorf("Expected URL to be 'https://example.com/song.mp3', got %s", url)
+	}
+
+	// Test GetExpiryTime
+	if expiry := audioBlock.GetExpiryTime(); expiry != &now {
+		t.Errorf("Expected expiry time to be %v, got %v", now, expiry)
+	}
+}
+
+func TestVideoBlockImplementsDownloadableFileBlock(t *testing.T) {
+	// Test setup
+	now := time.Now()
+	videoBlock := &VideoBlock{
+		Video: Video{
+			File: &FileObject{
+				URL:        "https://example.com/clip.mp4",
+				ExpiryTime: &now,
+			},
+		},
+	}
+
+	// Test GetURL
+	if url := videoBlock.GetURL(); url != "https://example.com/clip.mp4" {
+		t.Errorf("Expected URL to be 'https://example.com/clip.mp4', got %s", url)
+	}
+
+	// Test GetExpiryTime
+	if expiry := videoBlock.GetExpiryTime(); expiry != &now {
+		t.Errorf("Expected expiry time to be %v, got %v", now, expiry)
+	}
+}
+
 func TestExternalURLCases(t *testing.T) {
 	// Test External URLs for each block type
 	testCases := []struct {
@@ -114,6 +160,28 @@ func TestExternalURLCases(t *testing.T) {
 			},
 			expected: "https://external.com/image.jpg",
 		},
+		{
+			name: "Audio with external URL",
+			block: &AudioBlock{
+				Audio: Audio{
+					External: &FileObject{
+						URL: "https://external.com/song.mp3",
+					},
+				},
+			},
+			expected: "https://external.com/song.mp3",
+		},
+		{
+			name: "Video with external URL",
+			block: &VideoBlock{
+				Video: Video{
+					External: &FileObject{
+						URL: "https://external.com/clip.mp4",
+					},
+				},
+			},
+			expected: "https://external.com/clip.mp4",
+		},
 	}
 
 	for _, tc := range testCases {
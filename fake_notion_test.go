@@ -0,0 +1,401 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+// fakeNotion is an in-memory implementation of the block, page and database
+// endpoints, used to test operations that issue many dependent requests.
+// Objects are stored as raw JSON maps; created objects get the IDs "new-1",
+// "new-2" and so on.
+type fakeNotion struct {
+	t         *testing.T
+	blocks    map[string]map[string]any
+	children  map[string][]string
+	pages     map[string]map[string]any
+	databases map[string]map[string]any
+	rows      map[string][]string
+	created   int
+	// requests records "METHOD path" for every request.
+	requests []string
+	// fail makes requests fail with a validation error when it returns true
+	// for "METHOD path".
+	fail func(request string) bool
+}
+
+func newFakeNotion(t *testing.T) *fakeNotion {
+	return &fakeNotion{
+		t:         t,
+		blocks:    map[string]map[string]any{},
+		children:  map[string][]string{},
+		pages:     map[string]map[string]any{},
+		databases: map[string]map[string]any{},
+		rows:      map[string][]string{},
+	}
+}
+
+func (f *fakeNotion) client(opts ...notionapi.ClientOption) *notionapi.Client {
+	opts = append([]notionapi.ClientOption{notionapi.WithHTTPClient(newTestClient(f.roundTrip))}, opts...)
+	return notionapi.NewClient("some_token", opts...)
+}
+
+// addBlocks parses blocks, a JSON array of blocks with nested children in
+// their type specific objects, and appends them to parent.
+func (f *fakeNotion) addBlocks(parent string, blocks string) {
+	var raw []map[string]any
+	if err := json.Unmarshal([]byte(blocks), &raw); err != nil {
+		f.t.Fatal(err)
+	}
+	for _, b := range raw {
+		f.insert(parent, b, len(f.children[parent]))
+	}
+}
+
+func (f *fakeNotion) addPage(id string, page string) {
+	var raw map[string]any
+	if err := json.Unmarshal([]byte(page), &raw); err != nil {
+		f.t.Fatal(err)
+	}
+	raw["object"], raw["id"] = "page", id
+	f.pages[id] = raw
+}
+
+func (f *fakeNotion) addDatabase(id string, db string) {
+	var raw map[string]any
+	if err := json.Unmarshal([]byte(db), &raw); err != nil {
+		f.t.Fatal(err)
+	}
+	raw["object"], raw["id"] = "database", id
+	f.databases[id] = raw
+}
+
+// tree returns the content of the block with the given ID in the form
+// accepted by addBlocks, without IDs.
+func (f *fakeNotion) tree(id string) []map[string]any {
+	result := []map[string]any{}
+	for _, childID := range f.children[id] {
+		b := map[string]any{}
+		for k, v := range f.blocks[childID] {
+			b[k] = v
+		}
+		delete(b, "id")
+		delete(b, "object")
+		delete(b, "has_children")
+		if children := f.tree(childID); len(children) > 0 {
+			payload := map[string]any{}
+			for k, v := range b[b["type"].(string)].(map[string]any) {
+				payload[k] = v
+			}
+			payload["children"] = children
+			b[b["type"].(string)] = payload
+		}
+		result = append(result, b)
+	}
+	return result
+}
+
+func (f *fakeNotion) nextID() string {
+	f.created++
+	return fmt.Sprintf("new-%d", f.created)
+}
+
+// insert stores b and its nested children under parent at position i and
+// returns the stored block.
+func (f *fakeNotion) insert(parent string, b map[string]any, i int) map[string]any {
+	if _, ok := b["id"]; !ok {
+		b["id"] = f.nextID()
+	}
+	b["object"] = "block"
+	id := b["id"].(string)
+
+	f.blocks[id] = b
+	siblings := f.children[parent]
+	f.children[parent] = append(siblings[:i:i], append([]string{id}, siblings[i:]...)...)
+
+	if payload, ok := b[b["type"].(string)].(map[string]any); ok {
+		if children, ok := payload["children"].([]any); ok {
+			delete(payload, "children")
+			for j, child := range children {
+				f.insert(id, child.(map[string]any), j)
+			}
+		}
+	}
+	return f.withHasChildren(id)
+}
+
+func (f *fakeNotion) withHasChildren(id string) map[string]any {
+	b := f.blocks[id]
+	b["has_children"] = len(f.children[f.syncedOriginal(id)]) > 0
+	return b
+}
+
+// syncedOriginal returns the ID of the original block if id is a synced
+// block reference, whose children are those of the original, and id
+// otherwise.
+func (f *fakeNotion) syncedOriginal(id string) string {
+	if synced, ok := f.blocks[id]["synced_block"].(map[string]any); ok {
+		if from, ok := synced["synced_from"].(map[string]any); ok {
+			return from["block_id"].(string)
+		}
+	}
+	return id
+}
+
+func (f *fakeNotion) remove(id string) {
+	for parent, children := range f.children {
+		for i, child := range children {
+			if child == id {
+				f.children[parent] = append(children[:i:i], children[i+1:]...)
+			}
+		}
+	}
+}
+
+func (f *fakeNotion) roundTrip(req *http.Request) *http.Response {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/")
+	f.requests = append(f.requests, req.Method+" "+path)
+	parts := strings.Split(path, "/")
+	if f.fail != nil && f.fail(req.Method+" "+path) {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"object":"error","status":400,"code":"validation_error","message":"failed"}`)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	}
+
+	var body map[string]any
+	if req.Body != nil {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil && err != io.EOF {
+			f.t.Fatal(err)
+		}
+	}
+
+	switch {
+	case parts[0] == "blocks" && len(parts) == 3 && req.Method == http.MethodGet:
+		results := []any{}
+		for _, id := range f.children[f.syncedOriginal(parts[1])] {
+			results = append(results, f.withHasChildren(id))
+		}
+		return f.respond(map[string]any{"object": "list", "results": results, "has_more": false})
+	case parts[0] == "blocks" && len(parts) == 3 && req.Method == http.MethodPatch:
+		return f.appendChildren(parts[1], body)
+	case parts[0] == "blocks" && req.Method == http.MethodGet:
+		return f.respond(f.withHasChildren(parts[1]))
+	case parts[0] == "blocks" && req.Method == http.MethodPatch:
+		b := f.blocks[parts[1]]
+		for k, v := range body {
+			b[k] = v
+		}
+		return f.respond(f.withHasChildren(parts[1]))
+	case parts[0] == "blocks" && req.Method == http.MethodDelete:
+		f.remove(parts[1])
+		b := f.withHasChildren(parts[1])
+		b["archived"] = true
+		return f.respond(b)
+	case parts[0] == "pages" && req.Method == http.MethodPost:
+		return f.createPage(body)
+	case parts[0] == "pages" && req.Method == http.MethodGet:
+		return f.respond(f.pages[parts[1]])
+	case parts[0] == "pages" && req.Method == http.MethodPatch:
+		return f.updatePage(parts[1], body)
+	case parts[0] == "databases" && len(parts) == 3:
+		results := []any{}
+		for _, id := range f.rows[parts[1]] {
+			if f.matches(f.pages[id], body["filter"]) {
+				results = append(results, f.pages[id])
+			}
+		}
+		return f.respond(map[string]any{"object": "list", "results": results, "has_more": false})
+	case parts[0] == "databases" && req.Method == http.MethodPost:
+		return f.createDatabase(body)
+	case parts[0] == "databases" && req.Method == http.MethodGet:
+		return f.respond(f.databases[parts[1]])
+	case parts[0] == "databases" && req.Method == http.MethodPatch:
+		return f.updateDatabase(parts[1], body)
+	}
+	f.t.Fatalf("unexpected request %s %s", req.Method, path)
+	return nil
+}
+
+func (f *fakeNotion) appendChildren(parent string, body map[string]any) *http.Response {
+	i := len(f.children[parent])
+	if after, ok := body["after"].(string); ok {
+		for j, id := range f.children[parent] {
+			if id == after {
+				i = j + 1
+			}
+		}
+	}
+	results := []any{}
+	for _, child := range body["children"].([]any) {
+		results = append(results, f.insert(parent, child.(map[string]any), i))
+		i++
+	}
+	return f.respond(map[string]any{"object": "list", "results": results})
+}
+
+func (f *fakeNotion) createPage(body map[string]any) *http.Response {
+	id := f.nextID()
+	parent := body["parent"].(map[string]any)
+	page := map[string]any{"parent": parent, "properties": body["properties"]}
+	for _, k := range []string{"icon", "cover"} {
+		if v, ok := body[k]; ok {
+			page[k] = v
+		}
+	}
+	f.addPage(id, mustJSON(f.t, page))
+
+	if dbID, ok := parent["database_id"].(string); ok {
+		f.rows[dbID] = append(f.rows[dbID], id)
+	} else {
+		pageID := parent["page_id"].(string)
+		title := ""
+		if props, ok := body["properties"].(map[string]any); ok {
+			if t, ok := props["title"].(map[string]any); ok {
+				for _, rt := range t["title"].([]any) {
+					title += rt.(map[string]any)["text"].(map[string]any)["content"].(string)
+				}
+			}
+		}
+		f.insert(pageID, map[string]any{"id": id, "type": "child_page", "child_page": map[string]any{"title": title}}, len(f.children[pageID]))
+	}
+	if children, ok := body["children"].([]any); ok {
+		for i, child := range children {
+			f.insert(id, child.(map[string]any), i)
+		}
+	}
+	return f.respond(f.pages[id])
+}
+
+func (f *fakeNotion) updatePage(id string, body map[string]any) *http.Response {
+	page := f.pages[id]
+	if props, ok := body["properties"].(map[string]any); ok {
+		current := page["properties"].(map[string]any)
+		for k, v := range props {
+			current[k] = v
+		}
+	}
+	if archived, ok := body["archived"].(bool); ok && archived {
+		page["archived"] = true
+		for db, rows := range f.rows {
+			for i, row := range rows {
+				if row == id {
+					f.rows[db] = append(rows[:i:i], rows[i+1:]...)
+				}
+			}
+		}
+	}
+	return f.respond(page)
+}
+
+// matches reports whether page matches filter. Only equals filters of text
+// properties are supported, any other filter matches every page.
+func (f *fakeNotion) matches(page map[string]any, filter any) bool {
+	pf, ok := filter.(map[string]any)
+	if !ok {
+		return true
+	}
+	for _, typ := range []string{"title", "rich_text", "url", "email", "phone_number"} {
+		cond, ok := pf[typ].(map[string]any)
+		if !ok {
+			continue
+		}
+		property := page["properties"].(map[string]any)[pf["property"].(string)].(map[string]any)
+		if property["type"] != typ {
+			// Notion rejects filters of another property type.
+			f.t.Fatalf("%s filter for %s property %s", typ, property["type"], pf["property"])
+		}
+		text, ok := property[typ].(string)
+		if !ok {
+			for _, rt := range property[typ].([]any) {
+				text += rt.(map[string]any)["text"].(map[string]any)["content"].(string)
+			}
+		}
+		return text == cond["equals"]
+	}
+	return true
+}
+
+func (f *fakeNotion) createDatabase(body map[string]any) *http.Response {
+	id := f.nextID()
+	if props, ok := body["properties"].(map[string]any); ok {
+		for _, config := range props {
+			config.(map[string]any)["id"] = f.nextID()
+		}
+	}
+	f.addDatabase(id, mustJSON(f.t, body))
+	pageID := body["parent"].(map[string]any)["page_id"].(string)
+	f.insert(pageID, map[string]any{"id": id, "type": "child_database", "child_database": map[string]any{"title": ""}}, len(f.children[pageID]))
+	return f.respond(f.databases[id])
+}
+
+// updateDatabase applies the property changes of body: null removes a
+// property, a name renames it and any other key replaces its configuration.
+// Properties are addressed by name or ID.
+func (f *fakeNotion) updateDatabase(id string, body map[string]any) *http.Response {
+	db := f.databases[id]
+	props := db["properties"].(map[string]any)
+	changes, _ := body["properties"].(map[string]any)
+	for key, change := range changes {
+		name := key
+		for n, p := range props {
+			if n == key || p.(map[string]any)["id"] == key {
+				name = n
+			}
+		}
+		if change == nil {
+			delete(props, name)
+			continue
+		}
+		config, ok := props[name].(map[string]any)
+		if !ok {
+			config = map[string]any{"id": f.nextID()}
+		}
+		delete(props, name)
+		for k, v := range change.(map[string]any) {
+			switch k {
+			case "name":
+				name = v.(string)
+			case "id":
+			default:
+				for old := range config {
+					if old != "id" {
+						delete(config, old)
+					}
+				}
+			}
+		}
+		for k, v := range change.(map[string]any) {
+			if k != "name" && k != "id" {
+				config[k] = v
+			}
+		}
+		config["name"] = name
+		props[name] = config
+	}
+	return f.respond(db)
+}
+
+func (f *fakeNotion) respond(v any) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewBufferString(mustJSON(f.t, v))),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+}
+
+func mustJSON(t *testing.T, v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -46,7 +46,11 @@ func (f TimestampFilter) filter() {}
 
 type PropertyFilter struct {
 	Property    string                      `json:"property"`
+	Title       *TextFilterCondition        `json:"title,omitempty"`
 	RichText    *TextFilterCondition        `json:"rich_text,omitempty"`
+	URL         *TextFilterCondition        `json:"url,omitempty"`
+	Email       *TextFilterCondition        `json:"email,omitempty"`
+	PhoneNumber *TextFilterCondition        `json:"phone_number,omitempty"`
 	Number      *NumberFilterCondition      `json:"number,omitempty"`
 	Checkbox    *CheckboxFilterCondition    `json:"checkbox,omitempty"`
 	Select      *SelectFilterCondition      `json:"select,omitempty"`
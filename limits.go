@@ -0,0 +1,269 @@
+package notionapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Size limits enforced by the Notion API on request bodies.
+//
+// See https://developers.notion.com/reference/request-limits#size-limits
+const (
+	MaxArrayLength        = 100
+	MaxURLLength          = 2000
+	MaxEquationLength     = 1000
+	MaxEmailLength        = 200
+	MaxPhoneNumberLength  = 200
+	MaxBlocksPerRequest   = 1000
+	MaxPayloadSizeInBytes = 500 * 1000
+)
+
+// LimitViolation describes a single value of a request that exceeds one of
+// Notion's size limits. Path is the JSON path of the value, e.g.
+// "children[2].paragraph.rich_text[0].text.content".
+type LimitViolation struct {
+	Path   string
+	Limit  int
+	Actual int
+	Reason string
+}
+
+func (v LimitViolation) String() string {
+	path := v.Path
+	if path == "" {
+		path = "request"
+	}
+	return fmt.Sprintf("%s: %s (%d > %d)", path, v.Reason, v.Actual, v.Limit)
+}
+
+// LimitError is returned by the Validate methods of request types and lists
+// every limit violation found in the request.
+type LimitError struct {
+	Violations []LimitViolation
+}
+
+func (e *LimitError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.String()
+	}
+	return fmt.Sprintf("request exceeds Notion size limits: %s", strings.Join(parts, "; "))
+}
+
+// Validate checks the request against Notion's size limits and returns a
+// *LimitError listing every violation, or nil.
+func (r *PageCreateRequest) Validate() error {
+	return validateLimits(r)
+}
+
+// Validate checks the request against Notion's size limits and returns a
+// *LimitError listing every violation, or nil.
+func (r *AppendBlockChildrenRequest) Validate() error {
+	return validateLimits(r)
+}
+
+// Validate checks the request against Notion's size limits and returns a
+// *LimitError listing every violation, or nil.
+func (r *BlockUpdateRequest) Validate() error {
+	return validateLimits(r)
+}
+
+// Validate checks the request against Notion's size limits and returns a
+// *LimitError listing every violation, or nil.
+func (r *DatabaseCreateRequest) Validate() error {
+	return validateLimits(r)
+}
+
+var (
+	blockInterfaceType = reflect.TypeOf((*Block)(nil)).Elem()
+	richTextSliceType  = reflect.TypeOf([]RichText(nil))
+)
+
+func validateLimits(request any) error {
+	c := &limitChecker{}
+	c.walk("", reflect.ValueOf(request))
+
+	if c.blocks > MaxBlocksPerRequest {
+		c.add("", MaxBlocksPerRequest, c.blocks, "too many blocks")
+	}
+	if body, err := json.Marshal(request); err == nil && len(body) > MaxPayloadSizeInBytes {
+		c.add("", MaxPayloadSizeInBytes, len(body), "payload too large")
+	}
+
+	if len(c.violations) == 0 {
+		return nil
+	}
+	return &LimitError{Violations: c.violations}
+}
+
+type limitChecker struct {
+	violations []LimitViolation
+	blocks     int
+}
+
+func (c *limitChecker) add(path string, limit, actual int, reason string) {
+	c.violations = append(c.violations, LimitViolation{Path: path, Limit: limit, Actual: actual, Reason: reason})
+}
+
+func (c *limitChecker) checkLength(path, s string, limit int, reason string) {
+	if n := textLength(s); n > limit {
+		c.add(path, limit, n, reason)
+	}
+}
+
+func (c *limitChecker) walk(path string, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if v.Type() == blockInterfaceType {
+			c.blocks++
+		}
+		c.walk(path, v.Elem())
+	case reflect.Slice:
+		if v.Len() > MaxArrayLength {
+			c.add(path, MaxArrayLength, v.Len(), "too many elements")
+		}
+		for i := 0; i < v.Len(); i++ {
+			c.walk(fmt.Sprintf("%s[%d]", path, i), v.Index(i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			c.walk(joinPath(path, fmt.Sprint(iter.Key().Interface())), iter.Value())
+		}
+	case reflect.Struct:
+		c.walkStruct(path, v)
+	}
+}
+
+func (c *limitChecker) walkStruct(path string, v reflect.Value) {
+	switch s := v.Interface().(type) {
+	case Text:
+		c.checkLength(joinPath(path, "content"), s.Content, MaxRichTextContentLength, "text content too long")
+	case Equation:
+		c.checkLength(joinPath(path, "expression"), s.Expression, MaxEquationLength, "equation too long")
+	case EmailProperty:
+		c.checkLength(joinPath(path, "email"), s.Email, MaxEmailLength, "email too long")
+	case PhoneNumberProperty:
+		c.checkLength(joinPath(path, "phone_number"), s.PhoneNumber, MaxPhoneNumberLength, "phone number too long")
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+		fieldPath := path
+		if !field.Anonymous {
+			fieldPath = joinPath(path, name)
+		}
+		fv := v.Field(i)
+		if fv.Kind() == reflect.String && (name == "url" || field.Name == "Url") {
+			c.checkLength(fieldPath, fv.String(), MaxURLLength, "URL too long")
+			continue
+		}
+		c.walk(fieldPath, fv)
+	}
+}
+
+// jsonFieldName returns the name encoding/json uses for the field, and false
+// if the field is never encoded.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// fixLimits splits every rich text object of v that is longer than
+// MaxRichTextContentLength. Values are modified in place where they are
+// reachable through pointers; values stored by value in interfaces, slices
+// and maps are replaced by fixed copies.
+func fixLimits(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			fixLimits(v.Elem())
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		e := v.Elem()
+		if e.Kind() == reflect.Ptr {
+			fixLimits(e)
+			return
+		}
+		cp := reflect.New(e.Type()).Elem()
+		cp.Set(e)
+		fixLimits(cp)
+		if v.CanSet() {
+			v.Set(cp)
+		}
+	case reflect.Slice:
+		if v.Type() == richTextSliceType {
+			rt := v.Interface().([]RichText)
+			if needsSplit(rt) && v.CanSet() {
+				v.Set(reflect.ValueOf(SplitRichText(rt, MaxRichTextContentLength)))
+			}
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			fixLimits(v.Index(i))
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			cp := reflect.New(iter.Value().Type()).Elem()
+			cp.Set(iter.Value())
+			fixLimits(cp)
+			v.SetMapIndex(iter.Key(), cp)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fixLimits(v.Field(i))
+			}
+		}
+	}
+}
+
+func needsSplit(richText []RichText) bool {
+	for _, rt := range richText {
+		if rt.Text != nil && textLength(rt.Text.Content) > MaxRichTextContentLength {
+			return true
+		}
+	}
+	return false
+}
+
+// chunkBlocks splits blocks into chunks of at most MaxArrayLength blocks.
+func chunkBlocks(blocks []Block) [][]Block {
+	var chunks [][]Block
+	for len(blocks) > MaxArrayLength {
+		chunks = append(chunks, blocks[:MaxArrayLength])
+		blocks = blocks[MaxArrayLength:]
+	}
+	return append(chunks, blocks)
+}
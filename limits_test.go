@@ -0,0 +1,265 @@
+package notionapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func paragraphs(n int, content string) []notionapi.Block {
+	blocks := make([]notionapi.Block, n)
+	for i := range blocks {
+		blocks[i] = &notionapi.ParagraphBlock{
+			BasicBlock: notionapi.BasicBlock{Object: notionapi.ObjectTypeBlock, Type: notionapi.BlockTypeParagraph},
+			Paragraph: notionapi.Paragraph{
+				RichText: []notionapi.RichText{{Type: notionapi.RichTextTypeText, Text: &notionapi.Text{Content: content}}},
+			},
+		}
+	}
+	return blocks
+}
+
+func TestValidate(t *testing.T) {
+	long := strings.Repeat("a", notionapi.MaxRichTextContentLength+1)
+
+	tests := []struct {
+		name  string
+		req   interface{ Validate() error }
+		paths []string
+	}{
+		{
+			name:  "valid append request",
+			req:   &notionapi.AppendBlockChildrenRequest{Children: paragraphs(2, "ok")},
+			paths: nil,
+		},
+		{
+			name: "append request with too many children and long text",
+			req: &notionapi.AppendBlockChildrenRequest{
+				Children: append(paragraphs(100, "ok"), paragraphs(1, long)...),
+			},
+			paths: []string{"children", "children[100].paragraph.rich_text[0].text.content"},
+		},
+		{
+			name:  "emoji count as two characters",
+			req:   &notionapi.AppendBlockChildrenRequest{Children: paragraphs(1, strings.Repeat("😀", 1500))},
+			paths: []string{"children[0].paragraph.rich_text[0].text.content"},
+		},
+		{
+			name: "page create request",
+			req: &notionapi.PageCreateRequest{
+				Parent: notionapi.Parent{DatabaseID: "some_id"},
+				Properties: notionapi.Properties{
+					"Name": notionapi.TitleProperty{
+						Title: []notionapi.RichText{{Text: &notionapi.Text{Content: long}}},
+					},
+					"Link":  notionapi.URLProperty{URL: "https://example.com/" + strings.Repeat("a", notionapi.MaxURLLength)},
+					"Email": &notionapi.EmailProperty{Email: strings.Repeat("a", notionapi.MaxEmailLength+1)},
+				},
+			},
+			paths: []string{"properties.Email.email", "properties.Link.url", "properties.Name.title[0].text.content"},
+		},
+		{
+			name: "block update request",
+			req: &notionapi.BlockUpdateRequest{
+				Equation: &notionapi.Equation{Expression: strings.Repeat("x", notionapi.MaxEquationLength+1)},
+			},
+			paths: []string{"equation.expression"},
+		},
+		{
+			name: "database create request",
+			req: &notionapi.DatabaseCreateRequest{
+				Title: []notionapi.RichText{{Text: &notionapi.Text{Content: "ok", Link: &notionapi.Link{Url: "https://" + strings.Repeat("a", notionapi.MaxURLLength)}}}},
+			},
+			paths: []string{"title[0].text.link.url"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.paths == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var limitErr *notionapi.LimitError
+			if !errors.As(err, &limitErr) {
+				t.Fatalf("Validate() error = %v, want *LimitError", err)
+			}
+			var paths []string
+			for _, v := range limitErr.Violations {
+				paths = append(paths, v.Path)
+			}
+			sort.Strings(paths)
+			if !reflect.DeepEqual(paths, tt.paths) {
+				t.Errorf("Validate() paths = %q, want %q", paths, tt.paths)
+			}
+		})
+	}
+}
+
+// appendRecorder answers block children appends with one paragraph per
+// requested child and records every request body.
+type appendRecorder struct {
+	requests []notionapi.AppendBlockChildrenRequest
+	created  int
+}
+
+func (r *appendRecorder) roundTrip(t *testing.T) notionapi.ClientOption {
+	return notionapi.WithHTTPClient(newTestClient(func(req *http.Request) *http.Response {
+		var body struct {
+			After    notionapi.BlockID `json:"after"`
+			Children []json.RawMessage `json:"children"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		children := make(notionapi.Blocks, 0, len(body.Children))
+		raw, _ := json.Marshal(body.Children)
+		if err := json.Unmarshal(raw, &children); err != nil {
+			t.Fatal(err)
+		}
+		r.requests = append(r.requests, notionapi.AppendBlockChildrenRequest{After: body.After, Children: children})
+
+		results := make([]string, len(body.Children))
+		for i := range results {
+			r.created++
+			results[i] = fmt.Sprintf(`{"object":"block","id":"id-%d","type":"paragraph","paragraph":{"rich_text":[]}}`, r.created)
+		}
+		resp := fmt.Sprintf(`{"object":"list","results":[%s]}`, strings.Join(results, ","))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(resp)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}
+	}))
+}
+
+func TestAutoFixLimits(t *testing.T) {
+	t.Run("chunks children and splits rich text", func(t *testing.T) {
+		rec := &appendRecorder{}
+		client := notionapi.NewClient("some_token", rec.roundTrip(t), notionapi.WithAutoFixLimits(), notionapi.WithLimitValidation())
+
+		children := paragraphs(250, "ok")
+		children[0] = paragraphs(1, strings.Repeat("a", notionapi.MaxRichTextContentLength+10))[0]
+		got, err := client.Block.AppendChildren(context.Background(), "parent", &notionapi.AppendBlockChildrenRequest{
+			After:    "anchor",
+			Children: children,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got.Results) != 250 {
+			t.Errorf("AppendChildren() got %d results, want 250", len(got.Results))
+		}
+		wantAfter := []notionapi.BlockID{"anchor", "id-100", "id-200"}
+		wantLen := []int{100, 100, 50}
+		if len(rec.requests) != len(wantAfter) {
+			t.Fatalf("AppendChildren() sent %d requests, want %d", len(rec.requests), len(wantAfter))
+		}
+		for i, req := range rec.requests {
+			if req.After != wantAfter[i] || len(req.Children) != wantLen[i] {
+				t.Errorf("request %d: after = %s, children = %d; want %s, %d", i, req.After, len(req.Children), wantAfter[i], wantLen[i])
+			}
+		}
+		first := rec.requests[0].Children[0].(*notionapi.ParagraphBlock)
+		if n := len(first.Paragraph.RichText); n != 2 {
+			t.Errorf("long rich text split into %d objects, want 2", n)
+		}
+	})
+
+	t.Run("splits emoji by UTF-16 length", func(t *testing.T) {
+		rec := &appendRecorder{}
+		client := notionapi.NewClient("some_token", rec.roundTrip(t), notionapi.WithAutoFixLimits(), notionapi.WithLimitValidation())
+
+		_, err := client.Block.AppendChildren(context.Background(), "parent", &notionapi.AppendBlockChildrenRequest{
+			Children: paragraphs(1, strings.Repeat("😀", 1500)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		first := rec.requests[0].Children[0].(*notionapi.ParagraphBlock)
+		if n := len(first.Paragraph.RichText); n != 2 {
+			t.Errorf("emoji text split into %d objects, want 2", n)
+		}
+	})
+
+	t.Run("validation rejects requests without auto-fix", func(t *testing.T) {
+		rec := &appendRecorder{}
+		client := notionapi.NewClient("some_token", rec.roundTrip(t), notionapi.WithLimitValidation())
+
+		_, err := client.Block.AppendChildren(context.Background(), "parent", &notionapi.AppendBlockChildrenRequest{
+			Children: paragraphs(101, "ok"),
+		})
+		var limitErr *notionapi.LimitError
+		if !errors.As(err, &limitErr) {
+			t.Fatalf("AppendChildren() error = %v, want *LimitError", err)
+		}
+		if len(rec.requests) != 0 {
+			t.Errorf("AppendChildren() sent %d requests, want 0", len(rec.requests))
+		}
+	})
+}
+
+func TestAutoFixLimitsPartialFailure(t *testing.T) {
+	t.Run("append returns the chunks already appended", func(t *testing.T) {
+		fake := newFakeNotion(t)
+		fake.addPage("parent", `{"properties": {}}`)
+		appends := 0
+		fake.fail = func(request string) bool {
+			if request != "PATCH blocks/parent/children" {
+				return false
+			}
+			appends++
+			return appends == 2
+		}
+		client := fake.client(notionapi.WithAutoFixLimits())
+
+		got, err := client.Block.AppendChildren(context.Background(), "parent", &notionapi.AppendBlockChildrenRequest{
+			Children: paragraphs(150, "ok"),
+		})
+		if err == nil {
+			t.Fatal("AppendChildren() error = nil, want error of the second chunk")
+		}
+		if got == nil || len(got.Results) != notionapi.MaxArrayLength {
+			t.Errorf("AppendChildren() = %v, want the %d blocks of the first chunk", got, notionapi.MaxArrayLength)
+		}
+	})
+
+	t.Run("create returns the created page", func(t *testing.T) {
+		fake := newFakeNotion(t)
+		fake.addPage("parent", `{"properties": {}}`)
+		fake.fail = func(request string) bool {
+			return strings.HasPrefix(request, "PATCH blocks/")
+		}
+		client := fake.client(notionapi.WithAutoFixLimits())
+
+		page, err := client.Page.Create(context.Background(), &notionapi.PageCreateRequest{
+			Parent:     notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "parent"},
+			Properties: notionapi.Properties{},
+			Children:   paragraphs(150, "ok"),
+		})
+		if err == nil {
+			t.Fatal("Create() error = nil, want error of the remaining children")
+		}
+		if page == nil || page.ID == "" {
+			t.Fatalf("Create() page = %v, want the created page", page)
+		}
+		if n := len(fake.children[string(page.ID)]); n != notionapi.MaxArrayLength {
+			t.Errorf("created page has %d children, want %d", n, notionapi.MaxArrayLength)
+		}
+	})
+}
@@ -67,13 +67,33 @@ type TemplateMention struct {
 	TemplateMentionDate string              `json:"template_mention_date,omitempty"`
 }
 
+// LinkPreviewMention is a mention of a URL that Notion renders with a link
+// preview integration.
+type LinkPreviewMention struct {
+	URL string `json:"url"`
+}
+
+// LinkMention is a mention of a URL that Notion unfurled into a rich link.
+type LinkMention struct {
+	Href         string `json:"href"`
+	Title        string `json:"title,omitempty"`
+	Description  string `json:"description,omitempty"`
+	LinkAuthor   string `json:"link_author,omitempty"`
+	LinkProvider string `json:"link_provider,omitempty"`
+	IconURL      string `json:"icon_url,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
 type Mention struct {
-	Type            MentionType      `json:"type,omitempty"`
-	Database        *DatabaseMention `json:"database,omitempty"`
-	Page            *PageMention     `json:"page,omitempty"`
-	User            *User            `json:"user,omitempty"`
-	Date            *DateObject      `json:"date,omitempty"`
-	TemplateMention *TemplateMention `json:"template_mention,omitempty"`
+	Type            MentionType         `json:"type,omitempty"`
+	Database        *DatabaseMention    `json:"database,omitempty"`
+	Page            *PageMention        `json:"page,omitempty"`
+	User            *User               `json:"user,omitempty"`
+	Date            *DateObject         `json:"date,omitempty"`
+	TemplateMention *TemplateMention    `json:"template_mention,omitempty"`
+	LinkPreview     *LinkPreviewMention `json:"link_preview,omitempty"`
+	LinkMention     *LinkMention        `json:"link_mention,omitempty"`
+	CustomEmoji     *CustomEmoji        `json:"custom_emoji,omitempty"`
 }
 
 type RichText struct {
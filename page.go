@@ -19,6 +19,13 @@ type PageService interface {
 	Create(context.Context, *PageCreateRequest) (*Page, error)
 	Get(context.Context, PageID) (*Page, error)
 	Update(context.Context, PageID, *PageUpdateRequest) (*Page, error)
+	Duplicate(ctx context.Context, source PageID, destParent Parent, opts *DuplicateOptions) (*DuplicateResult, error)
+	GetProperty(ctx context.Context, id PageID, property PropertyID, pagination *Pagination) (*PropertyItemResponse, error)
+	GetFullProperty(ctx context.Context, id PageID, property PropertyID) (Property, error)
+	Archive(ctx context.Context, id PageID) (*Page, error)
+	Restore(ctx context.Context, id PageID) (*Page, error)
+	SetIcon(ctx context.Context, id PageID, icon *Icon) (*Page, error)
+	SetCover(ctx context.Context, id PageID, cover *Image) (*Page, error)
 }
 
 type PageClient struct {
@@ -37,10 +44,24 @@ type PageClient struct {
 // the children option. To add content to a page after creating it, use the
 // Append block children endpoint.
 //
-// Returns a new page object.
+// Returns a new page object. If the client fixes limits and appending the
+// children beyond the first MaxArrayLength fails, the created page is
+// returned together with the error.
 //
 // See https://developers.notion.com/reference/post-page
 func (pc *PageClient) Create(ctx context.Context, requestBody *PageCreateRequest) (*Page, error) {
+	var rest []Block
+	if pc.apiClient.autoFixLimits && len(requestBody.Children) > MaxArrayLength {
+		// Create the page with the first chunk of children and append the
+		// remaining ones once the page exists.
+		cp := *requestBody
+		cp.Children, rest = requestBody.Children[:MaxArrayLength], requestBody.Children[MaxArrayLength:]
+		requestBody = &cp
+	}
+	if err := pc.apiClient.prepareRequest(requestBody); err != nil {
+		return nil, err
+	}
+
 	res, err := pc.apiClient.request(ctx, http.MethodPost, "pages", nil, requestBody)
 	if err != nil {
 		return nil, err
@@ -52,7 +73,16 @@ func (pc *PageClient) Create(ctx context.Context, requestBody *PageCreateRequest
 		}
 	}()
 
-	return handlePageResponse(res)
+	page, err := handlePageResponse(res)
+	if err != nil || len(rest) == 0 {
+		return page, err
+	}
+
+	_, err = pc.apiClient.Block.AppendChildren(ctx, BlockID(page.ID), &AppendBlockChildrenRequest{Children: rest})
+	if err != nil {
+		return page, fmt.Errorf("page %s created but appending remaining children failed: %w", page.ID, err)
+	}
+	return page, nil
 }
 
 // PageCreateRequest represents the request body for PageClient.Create.
@@ -66,13 +96,25 @@ type PageCreateRequest struct {
 	Properties Properties `json:"properties"`
 	// The content to be rendered on the new page, represented as an array of
 	// block objects.
-	Children []Block `json:"children,omitempty"`
+	Children Blocks `json:"children,omitempty"`
 	// The icon of the new page. Either an emoji object or an external file object.
 	Icon *Icon `json:"icon,omitempty"`
 	// The cover image of the new page, represented as a file object.
 	Cover *Image `json:"cover,omitempty"`
 }
 
+// MarshalJSON leaves out the read-only fields of the children, so blocks read
+// from the API can be sent again.
+func (r PageCreateRequest) MarshalJSON() ([]byte, error) {
+	type alias PageCreateRequest
+	children, err := newBlocks(r.Children)
+	if err != nil {
+		return nil, err
+	}
+	r.Children = children
+	return json.Marshal(alias(r))
+}
+
 // Get Retrieves a Page object using the ID specified.
 //
 // Responses contains page properties, not page content. To fetch page content,
@@ -135,13 +177,79 @@ type PageUpdateRequest struct {
 	// is not included, then it is not changed.
 	Properties Properties `json:"properties,omitempty"`
 	// Whether the page is archived (deleted). Set to true to archive a page. Set
-	// to false to un-archive (restore) a page.
-	Archived bool `json:"archived"`
+	// to false to un-archive (restore) a page. If nil, it is not changed.
+	Archived *bool `json:"archived,omitempty"`
+	// Whether the page is in the trash. If nil, it is not changed.
+	InTrash *bool `json:"in_trash,omitempty"`
 	// A page icon for the page. Supported types are external file object or emoji
 	// object.
 	Icon *Icon `json:"icon,omitempty"`
 	// A cover image for the page. Only external file objects are supported.
 	Cover *Image `json:"cover,omitempty"`
+	// RemoveIcon removes the icon of the page if Icon is nil.
+	RemoveIcon bool `json:"-"`
+	// RemoveCover removes the cover of the page if Cover is nil.
+	RemoveCover bool `json:"-"`
+}
+
+// MarshalJSON sends null for the icon and cover if they are to be removed.
+func (r PageUpdateRequest) MarshalJSON() ([]byte, error) {
+	type alias PageUpdateRequest
+	raw := struct {
+		alias
+		Icon  any `json:"icon,omitempty"`
+		Cover any `json:"cover,omitempty"`
+	}{alias(r), nullIfRemoved(r.Icon, r.RemoveIcon), nullIfRemoved(r.Cover, r.RemoveCover)}
+	return json.Marshal(raw)
+}
+
+// nullIfRemoved returns v, or null if v is nil and is to be removed. It
+// returns nil otherwise, which omitempty leaves out.
+func nullIfRemoved[T any](v *T, remove bool) any {
+	switch {
+	case v != nil:
+		return v
+	case remove:
+		return json.RawMessage("null")
+	}
+	return nil
+}
+
+// emptyIfRemoved returns v, or [] if v is empty and is to be removed. It
+// returns nil otherwise, which omitempty leaves out.
+func emptyIfRemoved[T any](v []T, remove bool) any {
+	switch {
+	case len(v) > 0:
+		return v
+	case remove:
+		return []T{}
+	}
+	return nil
+}
+
+// Archive moves the page with the given ID to the trash.
+func (pc *PageClient) Archive(ctx context.Context, id PageID) (*Page, error) {
+	archived := true
+	return pc.Update(ctx, id, &PageUpdateRequest{Archived: &archived})
+}
+
+// Restore restores the archived page with the given ID. It clears both
+// archived and in_trash, since the API has used either flag for the trash.
+func (pc *PageClient) Restore(ctx context.Context, id PageID) (*Page, error) {
+	restored := false
+	return pc.Update(ctx, id, &PageUpdateRequest{Archived: &restored, InTrash: &restored})
+}
+
+// SetIcon sets the icon of the page with the given ID, or removes it if icon
+// is nil.
+func (pc *PageClient) SetIcon(ctx context.Context, id PageID, icon *Icon) (*Page, error) {
+	return pc.Update(ctx, id, &PageUpdateRequest{Icon: icon, RemoveIcon: icon == nil})
+}
+
+// SetCover sets the cover of the page with the given ID, or removes it if
+// cover is nil.
+func (pc *PageClient) SetCover(ctx context.Context, id PageID, cover *Image) (*Page, error) {
+	return pc.Update(ctx, id, &PageUpdateRequest{Cover: cover, RemoveCover: cover == nil})
 }
 
 // The Page object contains the page property values of a single Notion page.
@@ -155,6 +263,7 @@ type Page struct {
 	CreatedBy      User       `json:"created_by,omitempty"`
 	LastEditedBy   User       `json:"last_edited_by,omitempty"`
 	Archived       bool       `json:"archived"`
+	InTrash        bool       `json:"in_trash"`
 	Properties     Properties `json:"properties"`
 	Parent         Parent     `json:"parent"`
 	URL            string     `json:"url"`
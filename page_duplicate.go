@@ -0,0 +1,371 @@
+package notionapi
+
+import (
+	"context"
+	"reflect"
+)
+
+// DuplicateOptions configures PageClient.Duplicate.
+type DuplicateOptions struct {
+	// Subpages duplicates child pages recursively. Without it child pages are
+	// skipped.
+	Subpages bool
+	// DatabaseRows copies the rows of child databases. Without it only the
+	// schema of child databases is recreated.
+	DatabaseRows bool
+}
+
+// DuplicateResult is returned by PageClient.Duplicate.
+type DuplicateResult struct {
+	// Page is the copy of the source page.
+	Page *Page
+	// IDs maps the IDs of the source page and of every copied block, subpage,
+	// database and database row to the IDs of their copies. It can be used to
+	// rewrite links and mentions pointing into the source page.
+	IDs map[ObjectID]ObjectID
+	// Skipped lists the blocks that cannot be created through the API, like
+	// link previews or files uploaded to Notion, and were not copied.
+	Skipped []BlockID
+}
+
+// Duplicate creates a copy of the source page, including its properties, icon,
+// cover and all of its content, as a child of destParent.
+//
+// If destParent is a page, only the title of the source page is copied.
+// Otherwise every property that can be written through the API is copied by
+// name. Child pages and child databases are created at the end of the page
+// containing them, because the API cannot create them elsewhere.
+//
+// Relations of copied databases are created as single_property relations,
+// and relations of a database to itself point at its copy. Rollups are
+// remapped to the copied relations. Values of relations between rows of the
+// same database are not copied.
+func (pc *PageClient) Duplicate(ctx context.Context, source PageID, destParent Parent, opts *DuplicateOptions) (*DuplicateResult, error) {
+	if opts == nil {
+		opts = &DuplicateOptions{}
+	}
+	d := &duplicator{
+		pages:     pc,
+		databases: &DatabaseClient{apiClient: pc.apiClient},
+		blocks:    &BlockClient{apiClient: pc.apiClient},
+		opts:      opts,
+		result:    &DuplicateResult{IDs: map[ObjectID]ObjectID{}},
+	}
+
+	page, err := d.duplicatePage(ctx, source, destParent, nil)
+	if err != nil {
+		return nil, err
+	}
+	d.result.Page = page
+	return d.result, nil
+}
+
+type duplicator struct {
+	pages     *PageClient
+	databases *DatabaseClient
+	blocks    *BlockClient
+	opts      *DuplicateOptions
+	result    *DuplicateResult
+}
+
+// duplicatePage copies the page with the given ID to parent. If schema is
+// not nil, only properties present in schema are copied.
+func (d *duplicator) duplicatePage(ctx context.Context, id PageID, parent Parent, schema PropertyConfigs) (*Page, error) {
+	source, err := d.pages.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := d.blocks.GetTree(ctx, BlockID(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := d.pages.Create(ctx, &PageCreateRequest{
+		Parent:     parent,
+		Properties: copyProperties(source.Properties, parent, schema),
+		Icon:       copyIcon(source.Icon),
+		Cover:      copyCover(source.Cover),
+	})
+	if err != nil {
+		return nil, err
+	}
+	d.result.IDs[source.ID] = page.ID
+
+	a := &treeAppender{
+		client:  d.blocks,
+		ids:     d.result.IDs,
+		handles: d.handles,
+		handle: func(ctx context.Context, b Block) error {
+			return d.handle(ctx, b, PageID(page.ID))
+		},
+	}
+	if _, err := a.append(ctx, BlockID(page.ID), "", tree); err != nil {
+		return nil, err
+	}
+	d.result.Skipped = append(d.result.Skipped, a.skipped...)
+	return page, nil
+}
+
+func (d *duplicator) handles(b Block) bool {
+	switch b.(type) {
+	case *ChildPageBlock:
+		return d.opts.Subpages
+	case *ChildDatabaseBlock:
+		return true
+	}
+	return false
+}
+
+// handle copies the child page or child database b into the page with the
+// given ID.
+func (d *duplicator) handle(ctx context.Context, b Block, page PageID) error {
+	parent := Parent{Type: ParentTypePageID, PageID: page}
+	switch b.(type) {
+	case *ChildPageBlock:
+		_, err := d.duplicatePage(ctx, PageID(b.GetID()), parent, nil)
+		return err
+	case *ChildDatabaseBlock:
+		return d.duplicateDatabase(ctx, DatabaseID(b.GetID()), parent)
+	}
+	return nil
+}
+
+func (d *duplicator) duplicateDatabase(ctx context.Context, id DatabaseID, parent Parent) error {
+	source, err := d.databases.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Relations and rollups refer to other properties by ID, so they are
+	// added once the properties they refer to exist in the copy.
+	properties, relations, rollups := PropertyConfigs{}, PropertyConfigs{}, PropertyConfigs{}
+	for name, config := range source.Properties {
+		switch config.(type) {
+		case *RelationPropertyConfig:
+			relations[name] = config
+		case *RollupPropertyConfig:
+			rollups[name] = config
+		default:
+			if isCreatablePropertyConfig(config.GetType()) {
+				properties[name] = withoutID(config)
+			}
+		}
+	}
+	db, err := d.databases.Create(ctx, &DatabaseCreateRequest{
+		Parent:     parent,
+		Title:      source.Title,
+		Properties: properties,
+		IsInline:   source.IsInline,
+	})
+	if err != nil {
+		return err
+	}
+	d.result.IDs[source.ID] = db.ID
+
+	// Values of relations between rows of the source database would point
+	// at the source rows, so they are not copied.
+	skipped := map[string]bool{}
+	if len(relations) > 0 {
+		update := &DatabaseUpdateRequest{Properties: PropertyConfigs{}}
+		for name, config := range relations {
+			relation := copyRelationConfig(config.(*RelationPropertyConfig), source.ID, db.ID)
+			update.Properties[name] = relation
+			skipped[name] = relation.Relation.DatabaseID == DatabaseID(db.ID)
+		}
+		if db, err = d.databases.Update(ctx, DatabaseID(db.ID), update); err != nil {
+			return err
+		}
+	}
+	if update := copyRollupConfigs(rollups, source, db); len(update.Properties) > 0 {
+		if db, err = d.databases.Update(ctx, DatabaseID(db.ID), update); err != nil {
+			return err
+		}
+	}
+
+	schema := PropertyConfigs{}
+	for name, config := range db.Properties {
+		if !skipped[name] {
+			schema[name] = config
+		}
+	}
+	if !d.opts.DatabaseRows {
+		return nil
+	}
+	rowParent := Parent{Type: ParentTypeDatabaseID, DatabaseID: DatabaseID(db.ID)}
+	query := &DatabaseQueryRequest{PageSize: MaxArrayLength}
+	for {
+		res, err := d.databases.Query(ctx, id, query)
+		if err != nil {
+			return err
+		}
+		for _, row := range res.Results {
+			if _, err := d.duplicatePage(ctx, PageID(row.ID), rowParent, schema); err != nil {
+				return err
+			}
+		}
+		if !res.HasMore || res.NextCursor == "" {
+			return nil
+		}
+		query.StartCursor = res.NextCursor
+	}
+}
+
+// copyRelationConfig returns the configuration of a relation in the copy of
+// the source database. A relation of the source database to itself points
+// at the copy instead. The copy is always a single_property relation, since
+// a dual_property relation would add a back-relation to the related
+// database.
+func copyRelationConfig(config *RelationPropertyConfig, source, copy ObjectID) *RelationPropertyConfig {
+	target := config.Relation.DatabaseID
+	if sameID(string(target), string(source)) {
+		target = DatabaseID(copy)
+	}
+	return &RelationPropertyConfig{
+		Type: config.Type,
+		Relation: RelationConfig{
+			DatabaseID:     target,
+			Type:           RelationSingleProperty,
+			SingleProperty: &SingleProperty{},
+		},
+	}
+}
+
+// copyRollupConfigs returns the request adding the rollups of the source
+// database to its copy, whose relations have already been created. Rollup
+// IDs are looked up by name in the copy, or in the source database if they
+// refer to its properties by ID only. Rollups whose relation was not copied
+// are left out.
+func copyRollupConfigs(rollups PropertyConfigs, source, copy *Database) *DatabaseUpdateRequest {
+	update := &DatabaseUpdateRequest{Properties: PropertyConfigs{}}
+	for name, config := range rollups {
+		rollup := config.(*RollupPropertyConfig).Rollup
+		relationName := propertyConfigName(source.Properties, rollup.RelationPropertyName, rollup.RelationPropertyID)
+		relation, ok := copy.Properties[relationName].(*RelationPropertyConfig)
+		if !ok {
+			continue
+		}
+		rollup.RelationPropertyName, rollup.RelationPropertyID = relationName, relation.ID
+		if relation.Relation.DatabaseID == DatabaseID(copy.ID) {
+			// The rolled up property is part of the copy as well.
+			rollupName := propertyConfigName(source.Properties, rollup.RollupPropertyName, rollup.RollupPropertyID)
+			target, ok := copy.Properties[rollupName]
+			if !ok {
+				continue
+			}
+			rollup.RollupPropertyName, rollup.RollupPropertyID = rollupName, target.GetID()
+		}
+		update.Properties[name] = &RollupPropertyConfig{Type: config.GetType(), Rollup: rollup}
+	}
+	return update
+}
+
+// propertyConfigName returns name, or the name of the configuration with
+// the given ID if name is empty.
+func propertyConfigName(configs PropertyConfigs, name string, id PropertyID) string {
+	if name != "" {
+		return name
+	}
+	for n, config := range configs {
+		if config.GetID() == id {
+			return n
+		}
+	}
+	return ""
+}
+
+// copyProperties returns the properties of a page that can be sent when a
+// copy of it is created in parent. Pages that are not part of a database
+// only have a title.
+func copyProperties(properties Properties, parent Parent, schema PropertyConfigs) Properties {
+	result := Properties{}
+	for name, property := range properties {
+		if parent.DatabaseID == "" {
+			if title, ok := property.(*TitleProperty); ok {
+				result["title"] = &TitleProperty{Type: PropertyTypeTitle, Title: title.Title}
+			}
+			continue
+		}
+		if !isWritablePropertyType(property.GetType()) {
+			continue
+		}
+		if _, ok := schema[name]; schema != nil && !ok {
+			continue
+		}
+		if files, ok := property.(*FilesProperty); ok {
+			// Files uploaded to Notion cannot be attached through the API.
+			external := &FilesProperty{Files: []File{}}
+			for _, f := range files.Files {
+				if f.Type != FileTypeFile {
+					external.Files = append(external.Files, f)
+				}
+			}
+			property = external
+		}
+		result[name] = withoutID(property)
+	}
+	return result
+}
+
+// copyIcon returns icon if it can be set through the API, otherwise nil.
+func copyIcon(icon *Icon) *Icon {
+	if icon == nil || icon.Type == FileTypeFile {
+		return nil
+	}
+	return icon
+}
+
+// copyCover returns cover if it can be set through the API, otherwise nil.
+func copyCover(cover *Image) *Image {
+	if cover == nil || cover.External == nil {
+		return nil
+	}
+	return &Image{Type: FileTypeExternal, External: cover.External}
+}
+
+// isWritablePropertyType reports whether values of the property type can be
+// set through the API.
+func isWritablePropertyType(t PropertyType) bool {
+	switch t {
+	case PropertyTypeFormula, PropertyTypeRollup, PropertyTypeCreatedTime, PropertyTypeCreatedBy,
+		PropertyTypeLastEditedTime, PropertyTypeLastEditedBy, PropertyTypeUniqueID,
+		PropertyTypeVerification, PropertyTypeButton:
+		return false
+	}
+	return true
+}
+
+// isCreatablePropertyConfig reports whether a database property of the type
+// can be created through the API.
+func isCreatablePropertyConfig(t PropertyConfigType) bool {
+	switch t {
+	case PropertyConfigStatus, PropertyConfigVerification, PropertyConfigButton:
+		return false
+	}
+	return true
+}
+
+// withoutID returns a copy of v, a property or property config, with its ID
+// field cleared. IDs of properties differ between databases.
+func withoutID[T any](v T) T {
+	rv := reflect.ValueOf(v)
+	ptr := rv.Kind() == reflect.Ptr
+	if ptr {
+		if rv.IsNil() {
+			return v
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+
+	cp := reflect.New(rv.Type())
+	cp.Elem().Set(rv)
+	if id := cp.Elem().FieldByName("ID"); id.IsValid() && id.CanSet() {
+		id.Set(reflect.Zero(id.Type()))
+	}
+	if ptr {
+		return cp.Interface().(T)
+	}
+	return cp.Elem().Interface().(T)
+}
@@ -0,0 +1,196 @@
+package notionapi_test
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestPageClient_Duplicate(t *testing.T) {
+	f := newFakeNotion(t)
+	f.addPage("src", `{
+		"parent": {"type": "workspace", "workspace": true},
+		"icon": {"type": "emoji", "emoji": "🚀"},
+		"properties": {"title": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Kickoff"}}]}}
+	}`)
+	f.addBlocks("src", `[
+		{"type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "intro"}}], "children": [
+			{"type": "toggle", "toggle": {"rich_text": [{"type": "text", "text": {"content": "more"}}], "children": [
+				{"type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "deep"}}]}}
+			]}}
+		]}},
+		{"type": "column_list", "column_list": {"children": [
+			{"type": "column", "column": {"children": [
+				{"type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "left"}}], "children": [
+					{"type": "bulleted_list_item", "bulleted_list_item": {"rich_text": [{"type": "text", "text": {"content": "nested"}}]}}
+				]}}
+			]}},
+			{"type": "column", "column": {"children": [
+				{"type": "paragraph", "paragraph": {"rich_text": [{"type": "text", "text": {"content": "right"}}]}}
+			]}}
+		]}},
+		{"type": "table", "table": {"table_width": 2, "has_column_header": true, "has_row_header": false, "children": [
+			{"type": "table_row", "table_row": {"cells": [[{"type": "text", "text": {"content": "a"}}], []]}},
+			{"type": "table_row", "table_row": {"cells": [[], [{"type": "text", "text": {"content": "b"}}]]}}
+		]}},
+		{"id": "uploaded", "type": "image", "image": {"type": "file", "file": {"url": "https://s3.example.com/a.png"}}},
+		{"id": "sub", "type": "child_page", "child_page": {"title": "Sub"}},
+		{"id": "db", "type": "child_database", "child_database": {"title": ""}}
+	]`)
+	f.addPage("sub", `{
+		"parent": {"type": "page_id", "page_id": "src"},
+		"properties": {"title": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Sub"}}]}}
+	}`)
+	f.addBlocks("sub", `[{"type": "quote", "quote": {"rich_text": [{"type": "text", "text": {"content": "sub content"}}]}}]`)
+	f.addDatabase("db", `{
+		"parent": {"type": "page_id", "page_id": "src"},
+		"title": [{"type": "text", "text": {"content": "Tasks"}}],
+		"properties": {
+			"Name": {"id": "a", "type": "title", "title": {}},
+			"Done": {"id": "b", "type": "checkbox", "checkbox": {}},
+			"Created": {"id": "c", "type": "created_time", "created_time": {}},
+			"Status": {"id": "d", "type": "status", "status": {"options": []}}
+		}
+	}`)
+	f.addPage("row", `{
+		"parent": {"type": "database_id", "database_id": "db"},
+		"properties": {
+			"Name": {"id": "a", "type": "title", "title": [{"type": "text", "text": {"content": "Row"}}]},
+			"Done": {"id": "b", "type": "checkbox", "checkbox": true},
+			"Created": {"id": "c", "type": "created_time", "created_time": "2023-01-01T00:00:00.000Z"},
+			"Status": {"id": "d", "type": "status", "status": {"name": "Open"}}
+		}
+	}`)
+	f.rows["db"] = []string{"row"}
+
+	got, err := f.client().Page.Duplicate(context.Background(), "src", notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "dest"}, &notionapi.DuplicateOptions{
+		Subpages:     true,
+		DatabaseRows: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Page.Icon == nil || got.Page.Icon.Emoji == nil || *got.Page.Icon.Emoji != "🚀" {
+		t.Errorf("Duplicate() icon = %+v, want 🚀", got.Page.Icon)
+	}
+	if !reflect.DeepEqual(got.Skipped, []notionapi.BlockID{"uploaded"}) {
+		t.Errorf("Duplicate() skipped = %v, want [uploaded]", got.Skipped)
+	}
+
+	newID := string(got.IDs["src"])
+	if newID != string(got.Page.ID) {
+		t.Fatalf("Duplicate() IDs[src] = %s, want %s", newID, got.Page.ID)
+	}
+	for _, id := range []notionapi.ObjectID{"sub", "db", "row"} {
+		if got.IDs[id] == "" {
+			t.Errorf("Duplicate() IDs has no entry for %s", id)
+		}
+	}
+
+	// Apart from the skipped image, the copy has the same content. Child
+	// pages and databases are compared through their copied content.
+	want := f.tree("src")
+	want = append(want[:3:3], want[4:]...)
+	if got, want := mustJSON(t, f.tree(newID)), mustJSON(t, want); got != want {
+		t.Errorf("copied tree = %s\nwant %s", got, want)
+	}
+
+	var schema []string
+	for name := range f.databases[string(got.IDs["db"])]["properties"].(map[string]any) {
+		schema = append(schema, name)
+	}
+	sort.Strings(schema)
+	if want := []string{"Created", "Done", "Name"}; !reflect.DeepEqual(schema, want) {
+		t.Errorf("copied schema = %v, want %v", schema, want)
+	}
+
+	var values []string
+	for name := range f.pages[string(got.IDs["row"])]["properties"].(map[string]any) {
+		values = append(values, name)
+	}
+	sort.Strings(values)
+	if want := []string{"Done", "Name"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("copied row properties = %v, want %v", values, want)
+	}
+}
+
+func TestPageClient_DuplicateRelations(t *testing.T) {
+	f := newFakeNotion(t)
+	f.addPage("src", `{
+		"parent": {"type": "workspace", "workspace": true},
+		"properties": {"title": {"id": "title", "type": "title", "title": []}}
+	}`)
+	f.addBlocks("src", `[{"id": "db", "type": "child_database", "child_database": {"title": ""}}]`)
+	f.addDatabase("db", `{
+		"parent": {"type": "page_id", "page_id": "src"},
+		"title": [],
+		"properties": {
+			"Name": {"id": "title", "type": "title", "title": {}},
+			"Done": {"id": "d", "type": "checkbox", "checkbox": {}},
+			"Parent": {"id": "p", "type": "relation", "relation": {"database_id": "db", "type": "dual_property", "dual_property": {}}},
+			"Projects": {"id": "o", "type": "relation", "relation": {"database_id": "other", "type": "dual_property", "dual_property": {}}},
+			"Parent done": {"id": "r1", "type": "rollup", "rollup": {"relation_property_name": "Parent", "relation_property_id": "p", "rollup_property_name": "Done", "rollup_property_id": "d", "function": "checked"}},
+			"Project count": {"id": "r2", "type": "rollup", "rollup": {"relation_property_name": "", "relation_property_id": "o", "rollup_property_name": "Name", "rollup_property_id": "x", "function": "count"}}
+		}
+	}`)
+	f.addPage("row", `{
+		"parent": {"type": "database_id", "database_id": "db"},
+		"properties": {
+			"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": "Row"}}]},
+			"Parent": {"id": "p", "type": "relation", "relation": [{"id": "row"}]},
+			"Projects": {"id": "o", "type": "relation", "relation": [{"id": "project"}]}
+		}
+	}`)
+	f.rows["db"] = []string{"row"}
+
+	got, err := f.client().Page.Duplicate(context.Background(), "src", notionapi.Parent{Type: notionapi.ParentTypePageID, PageID: "dest"}, &notionapi.DuplicateOptions{
+		DatabaseRows: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	copyID := string(got.IDs["db"])
+	props := f.databases[copyID]["properties"].(map[string]any)
+	config := func(name string) map[string]any {
+		t.Helper()
+		c, ok := props[name].(map[string]any)
+		if !ok {
+			t.Fatalf("copied schema has no property %s", name)
+		}
+		return c
+	}
+	id := func(name string) any { return config(name)["id"] }
+
+	wantRelations := map[string]string{"Parent": copyID, "Projects": "other"}
+	for name, target := range wantRelations {
+		relation := config(name)["relation"].(map[string]any)
+		if relation["database_id"] != target || relation["type"] != "single_property" {
+			t.Errorf("%s relation = %v, want single_property relation to %s", name, relation, target)
+		}
+	}
+
+	wantRollups := map[string][2]any{
+		"Parent done":   {id("Parent"), id("Done")},
+		"Project count": {id("Projects"), "x"},
+	}
+	for name, ids := range wantRollups {
+		rollup := config(name)["rollup"].(map[string]any)
+		if rollup["relation_property_id"] != ids[0] || rollup["rollup_property_id"] != ids[1] {
+			t.Errorf("%s rollup = %v, want relation %v and rollup property %v", name, rollup, ids[0], ids[1])
+		}
+	}
+
+	var values []string
+	for name := range f.pages[string(got.IDs["row"])]["properties"].(map[string]any) {
+		values = append(values, name)
+	}
+	sort.Strings(values)
+	if want := []string{"Name", "Projects"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("copied row properties = %v, want %v", values, want)
+	}
+}
@@ -0,0 +1,193 @@
+package notionapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// GetProperty retrieves the value of a single property of a page. Pages
+// returned by Get hold at most 25 items of title, rich_text, relation,
+// people and rollup properties; this endpoint returns all of them, split
+// into pages of results. See GetFullProperty to read all results at once.
+//
+// See https://developers.notion.com/reference/retrieve-a-page-property
+func (pc *PageClient) GetProperty(ctx context.Context, id PageID, property PropertyID, pagination *Pagination) (*PropertyItemResponse, error) {
+	res, err := pc.apiClient.request(ctx, http.MethodGet, fmt.Sprintf("pages/%s/properties/%s", id.String(), property.String()), pagination.ToQuery(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if errClose := res.Body.Close(); errClose != nil {
+			log.Println("failed to close body, should never happen")
+		}
+	}()
+
+	var response PropertyItemResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetFullProperty retrieves the value of a property of a page, following
+// the pagination of GetProperty, and returns it as a single Property, e.g. a
+// TitleProperty holding the complete title or a RelationProperty holding
+// every related page.
+func (pc *PageClient) GetFullProperty(ctx context.Context, id PageID, property PropertyID) (Property, error) {
+	var items []Property
+	pagination := &Pagination{PageSize: MaxArrayLength}
+	for {
+		res, err := pc.GetProperty(ctx, id, property, pagination)
+		if err != nil {
+			return nil, err
+		}
+		if res.Object != ObjectTypeList {
+			return res.Property, nil
+		}
+		items = append(items, res.Results...)
+		if !res.HasMore || res.NextCursor == "" {
+			return mergePropertyItems(res.Property, items)
+		}
+		pagination.StartCursor = res.NextCursor
+	}
+}
+
+// PropertyItemResponse is the response of PageClient.GetProperty.
+//
+// For properties with a single value Object is "property_item" and Property
+// holds the value. For paginated properties Object is "list", Results holds
+// one Property per item, e.g. a TitleProperty with a single rich text object
+// or a RelationProperty with a single relation, and Property describes the
+// whole list, e.g. the computed value of a rollup.
+type PropertyItemResponse struct {
+	Object     ObjectType
+	Property   Property
+	Results    []Property
+	HasMore    bool
+	NextCursor Cursor
+}
+
+func (r *PropertyItemResponse) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Object       ObjectType       `json:"object"`
+		Results      []map[string]any `json:"results"`
+		HasMore      bool             `json:"has_more"`
+		NextCursor   Cursor           `json:"next_cursor"`
+		PropertyItem map[string]any   `json:"property_item"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*r = PropertyItemResponse{Object: raw.Object, HasMore: raw.HasMore, NextCursor: raw.NextCursor}
+
+	if raw.Object != ObjectTypeList {
+		var item map[string]any
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		p, err := decodePropertyItem(item, false)
+		if err != nil {
+			return err
+		}
+		r.Property = p
+		return nil
+	}
+
+	if raw.PropertyItem != nil {
+		p, err := decodePropertyItem(raw.PropertyItem, true)
+		if err != nil {
+			return err
+		}
+		r.Property = p
+	}
+	r.Results = make([]Property, len(raw.Results))
+	for i, item := range raw.Results {
+		p, err := decodePropertyItem(item, false)
+		if err != nil {
+			return err
+		}
+		r.Results[i] = p
+	}
+	return nil
+}
+
+// listPropertyTypes are the types of properties returned as lists of
+// property items, holding a single value each.
+var listPropertyTypes = map[PropertyType]bool{
+	PropertyTypeTitle:    true,
+	PropertyTypeRichText: true,
+	PropertyTypeRelation: true,
+	PropertyTypePeople:   true,
+}
+
+// decodePropertyItem decodes a property item. The single value of an item
+// of a list property is wrapped into an array, so it can be decoded like a
+// page property. If header is true, item is the property_item field of a
+// list response, whose value is empty for list properties.
+func decodePropertyItem(item map[string]any, header bool) (Property, error) {
+	typ, _ := item["type"].(string)
+	if listPropertyTypes[PropertyType(typ)] {
+		if header {
+			item[typ] = []any{}
+		} else if value, ok := item[typ]; ok {
+			item[typ] = []any{value}
+		}
+	}
+
+	p, err := decodeProperty(item)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// mergePropertyItems combines the items of a list response into a single
+// property. header is the property_item field of the response.
+func mergePropertyItems(header Property, items []Property) (Property, error) {
+	switch p := header.(type) {
+	case *TitleProperty:
+		for _, item := range items {
+			if t, ok := item.(*TitleProperty); ok {
+				p.Title = append(p.Title, t.Title...)
+			}
+		}
+	case *RichTextProperty:
+		for _, item := range items {
+			if t, ok := item.(*RichTextProperty); ok {
+				p.RichText = append(p.RichText, t.RichText...)
+			}
+		}
+	case *RelationProperty:
+		for _, item := range items {
+			if r, ok := item.(*RelationProperty); ok {
+				p.Relation = append(p.Relation, r.Relation...)
+			}
+		}
+	case *PeopleProperty:
+		for _, item := range items {
+			if u, ok := item.(*PeopleProperty); ok {
+				p.People = append(p.People, u.People...)
+			}
+		}
+	case *RollupProperty:
+		// The items of a rollup are the values it is computed from. They are
+		// its value if the rollup shows the original values.
+		if p.Rollup.Type == RollupTypeArray {
+			p.Rollup.Array = PropertyArray(items)
+		}
+	case nil:
+		return nil, fmt.Errorf("list response without property_item")
+	}
+	return header, nil
+}
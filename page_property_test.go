@@ -0,0 +1,116 @@
+package notionapi_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestPageClientGetProperty(t *testing.T) {
+	tests := []struct {
+		name     string
+		filePath string
+		want     *notionapi.PropertyItemResponse
+	}{
+		{
+			name:     "returns a single value",
+			filePath: "testdata/page_property_number.json",
+			want: &notionapi.PropertyItemResponse{
+				Object:   notionapi.ObjectTypePropertyItem,
+				Property: &notionapi.NumberProperty{ID: "kjPO", Type: notionapi.PropertyTypeNumber, Number: ptr(2.0)},
+			},
+		},
+		{
+			name:     "returns a list of relation items",
+			filePath: "testdata/page_property_relation.json",
+			want: &notionapi.PropertyItemResponse{
+				Object:   notionapi.ObjectTypeList,
+				Property: &notionapi.RelationProperty{ID: "vYdV", Type: notionapi.PropertyTypeRelation, Relation: []notionapi.Relation{}},
+				Results: []notionapi.Property{
+					&notionapi.RelationProperty{ID: "vYdV", Type: notionapi.PropertyTypeRelation, Relation: []notionapi.Relation{{ID: "page_1"}}},
+					&notionapi.RelationProperty{ID: "vYdV", Type: notionapi.PropertyTypeRelation, Relation: []notionapi.Relation{{ID: "page_2"}}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newMockedClient(t, tt.filePath, http.StatusOK)
+			client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+			got, err := client.Page.GetProperty(context.Background(), "some_id", "some_property", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetProperty() = %s, want %s", mustJSON(t, got), mustJSON(t, tt.want))
+			}
+		})
+	}
+}
+
+func TestPageClientGetFullProperty(t *testing.T) {
+	respond := func(t *testing.T, file string) *http.Response {
+		b, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: b, Header: http.Header{"Content-Type": []string{"application/json"}}}
+	}
+
+	t.Run("follows pagination", func(t *testing.T) {
+		var queries []string
+		c := newTestClient(func(req *http.Request) *http.Response {
+			queries = append(queries, req.URL.RawQuery)
+			if req.URL.Query().Get("start_cursor") == "some_cursor" {
+				return respond(t, "testdata/page_property_title_2.json")
+			}
+			return respond(t, "testdata/page_property_title.json")
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		got, err := client.Page.GetFullProperty(context.Background(), "some_id", "title")
+		if err != nil {
+			t.Fatal(err)
+		}
+		title, ok := got.(*notionapi.TitleProperty)
+		if !ok {
+			t.Fatalf("GetFullProperty() = %T, want *TitleProperty", got)
+		}
+		if text := notionapi.PlainText(title.Title); text != "Hello big world" || len(title.Title) != 3 {
+			t.Errorf("GetFullProperty() title = %q in %d objects", text, len(title.Title))
+		}
+		if want := []string{"page_size=100", "page_size=100&start_cursor=some_cursor"}; !reflect.DeepEqual(queries, want) {
+			t.Errorf("queries = %v, want %v", queries, want)
+		}
+	})
+
+	t.Run("returns the computed rollup", func(t *testing.T) {
+		c := newTestClient(func(req *http.Request) *http.Response {
+			return respond(t, "testdata/page_property_rollup.json")
+		})
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		got, err := client.Page.GetFullProperty(context.Background(), "some_id", "smgU")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &notionapi.RollupProperty{ID: "smgU", Type: notionapi.PropertyTypeRollup, Rollup: notionapi.Rollup{Type: notionapi.RollupTypeNumber, Number: ptr(3.0), Function: notionapi.FunctionSum}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetFullProperty() = %s, want %s", mustJSON(t, got), mustJSON(t, want))
+		}
+	})
+
+	t.Run("returns a single value", func(t *testing.T) {
+		c := newMockedClient(t, "testdata/page_property_number.json", http.StatusOK)
+		client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+		got, err := client.Page.GetFullProperty(context.Background(), "some_id", "kjPO")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n, ok := got.(*notionapi.NumberProperty); !ok || n.Number == nil || *n.Number != 2 {
+			t.Errorf("GetFullProperty() = %s", mustJSON(t, got))
+		}
+	})
+}
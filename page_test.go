@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -132,11 +133,11 @@ func TestPageClient(t *testing.T) {
 								Array: notionapi.PropertyArray{
 									&notionapi.NumberProperty{
 										Type:   "number",
-										Number: 42.2,
+										Number: ptr(42.2),
 									},
 									&notionapi.NumberProperty{
 										Type:   "number",
-										Number: 56,
+										Number: ptr(56.0),
 									},
 								},
 							},
@@ -521,7 +522,30 @@ func TestPageUpdateRequest_MarshallJSON(t *testing.T) {
 					},
 				},
 			},
-			want: []byte(`{"properties":{"Checked":{"checkbox":false}},"archived":false}`),
+			want: []byte(`{"properties":{"Checked":{"checkbox":false}}}`),
+		},
+		{
+			name: "archive",
+			req:  &notionapi.PageUpdateRequest{Archived: ptr(true)},
+			want: []byte(`{"archived":true}`),
+		},
+		{
+			name: "restore from trash",
+			req:  &notionapi.PageUpdateRequest{InTrash: ptr(false)},
+			want: []byte(`{"in_trash":false}`),
+		},
+		{
+			name: "set icon and remove cover",
+			req: &notionapi.PageUpdateRequest{
+				Icon:        &notionapi.Icon{Type: "emoji", Emoji: ptr(notionapi.Emoji("🎉"))},
+				RemoveCover: true,
+			},
+			want: []byte(`{"icon":{"type":"emoji","emoji":"🎉"},"cover":null}`),
+		},
+		{
+			name: "remove icon",
+			req:  &notionapi.PageUpdateRequest{RemoveIcon: true},
+			want: []byte(`{"icon":null}`),
 		},
 	}
 
@@ -538,3 +562,128 @@ func TestPageUpdateRequest_MarshallJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestPageClientUpdateClearsProperties(t *testing.T) {
+	var body string
+	c := newRecordingClient(t, "testdata/page_update.json", &body)
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+
+	_, err := client.Page.Update(context.Background(), "some_id", &notionapi.PageUpdateRequest{
+		Properties: notionapi.Properties{
+			"Title":       &notionapi.TitleProperty{},
+			"RichText":    &notionapi.RichTextProperty{},
+			"Number":      &notionapi.NumberProperty{},
+			"Select":      &notionapi.SelectProperty{},
+			"MultiSelect": &notionapi.MultiSelectProperty{},
+			"Status":      &notionapi.StatusProperty{},
+			"Date":        &notionapi.DateProperty{},
+			"Relation":    &notionapi.RelationProperty{},
+			"People":      &notionapi.PeopleProperty{},
+			"Files":       &notionapi.FilesProperty{},
+			"Checkbox":    &notionapi.CheckboxProperty{},
+			"URL":         &notionapi.URLProperty{},
+			"Email":       &notionapi.EmailProperty{},
+			"PhoneNumber": &notionapi.PhoneNumberProperty{},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got, want map[string]any
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatal(err)
+	}
+	err = json.Unmarshal([]byte(`{"properties":{
+		"Title":{"title":[]},
+		"RichText":{"rich_text":[]},
+		"Number":{"number":null},
+		"Select":{"select":null},
+		"MultiSelect":{"multi_select":[]},
+		"Status":{"status":null},
+		"Date":{"date":null},
+		"Relation":{"relation":[]},
+		"People":{"people":[]},
+		"Files":{"files":[]},
+		"Checkbox":{"checkbox":false},
+		"URL":{"url":null},
+		"Email":{"email":null},
+		"PhoneNumber":{"phone_number":null}
+	}}`), &want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("request body = %s", body)
+	}
+}
+
+func TestPageEmptyProperties(t *testing.T) {
+	var page notionapi.Page
+	err := json.Unmarshal([]byte(`{"object":"page","id":"some_id","properties":{
+		"Number":{"id":"a","type":"number","number":null},
+		"Zero":{"id":"b","type":"number","number":0},
+		"Select":{"id":"c","type":"select","select":null},
+		"Status":{"id":"d","type":"status","status":null},
+		"Date":{"id":"e","type":"date","date":null},
+		"URL":{"id":"f","type":"url","url":null},
+		"Email":{"id":"g","type":"email","email":null},
+		"PhoneNumber":{"id":"h","type":"phone_number","phone_number":null}
+	}}`), &page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := notionapi.Properties{
+		"Number":      &notionapi.NumberProperty{ID: "a", Type: notionapi.PropertyTypeNumber},
+		"Zero":        &notionapi.NumberProperty{ID: "b", Type: notionapi.PropertyTypeNumber, Number: ptr(0.0)},
+		"Select":      &notionapi.SelectProperty{ID: "c", Type: notionapi.PropertyTypeSelect},
+		"Status":      &notionapi.StatusProperty{ID: "d", Type: notionapi.PropertyTypeStatus},
+		"Date":        &notionapi.DateProperty{ID: "e", Type: notionapi.PropertyTypeDate},
+		"URL":         &notionapi.URLProperty{ID: "f", Type: notionapi.PropertyTypeURL},
+		"Email":       &notionapi.EmailProperty{ID: "g", Type: notionapi.PropertyTypeEmail},
+		"PhoneNumber": &notionapi.PhoneNumberProperty{ID: "h", Type: notionapi.PropertyTypePhoneNumber},
+	}
+	if !reflect.DeepEqual(page.Properties, want) {
+		t.Errorf("Properties = %s", mustJSON(t, page.Properties))
+	}
+
+	// Encoding the decoded properties sends null for the empty values again.
+	for name, value := range map[string]string{
+		"Number": `"number":null`, "Zero": `"number":0`, "Select": `"select":null`, "URL": `"url":null`,
+	} {
+		if data := mustJSON(t, page.Properties[name]); !strings.Contains(data, value) {
+			t.Errorf("%s encodes as %s, want %s", name, data, value)
+		}
+	}
+}
+
+func TestPageClientUpdateHelpers(t *testing.T) {
+	var body string
+	c := newRecordingClient(t, "testdata/page_update.json", &body)
+	client := notionapi.NewClient("some_token", notionapi.WithHTTPClient(c))
+	ctx := context.Background()
+	cover := &notionapi.Image{Type: notionapi.FileTypeExternal, External: &notionapi.FileObject{URL: "https://example.com/c.png"}}
+
+	tests := []struct {
+		name string
+		call func() (*notionapi.Page, error)
+		want string
+	}{
+		{"archive", func() (*notionapi.Page, error) { return client.Page.Archive(ctx, "some_id") }, `{"archived":true}`},
+		{"restore", func() (*notionapi.Page, error) { return client.Page.Restore(ctx, "some_id") }, `{"archived":false,"in_trash":false}`},
+		{"remove icon", func() (*notionapi.Page, error) { return client.Page.SetIcon(ctx, "some_id", nil) }, `{"icon":null}`},
+		{"set cover", func() (*notionapi.Page, error) { return client.Page.SetCover(ctx, "some_id", cover) }, `{"cover":{"type":"external","external":{"url":"https://example.com/c.png"}}}`},
+		{"remove cover", func() (*notionapi.Page, error) { return client.Page.SetCover(ctx, "some_id", nil) }, `{"cover":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			if body != tt.want {
+				t.Errorf("request body = %s, want %s", body, tt.want)
+			}
+		})
+	}
+}
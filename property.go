@@ -43,6 +43,15 @@ type TitleProperty struct {
 	Title []RichText   `json:"title"`
 }
 
+// MarshalJSON encodes a nil title as [], which clears the property.
+func (p TitleProperty) MarshalJSON() ([]byte, error) {
+	type alias TitleProperty
+	return json.Marshal(struct {
+		alias
+		Title []RichText `json:"title"`
+	}{alias(p), emptyIfNil(p.Title)})
+}
+
 func (p TitleProperty) GetID() string {
 	return p.ID.String()
 }
@@ -57,6 +66,15 @@ type RichTextProperty struct {
 	RichText []RichText   `json:"rich_text"`
 }
 
+// MarshalJSON encodes nil rich text as [], which clears the property.
+func (p RichTextProperty) MarshalJSON() ([]byte, error) {
+	type alias RichTextProperty
+	return json.Marshal(struct {
+		alias
+		RichText []RichText `json:"rich_text"`
+	}{alias(p), emptyIfNil(p.RichText)})
+}
+
 func (p RichTextProperty) GetID() string {
 	return p.ID.String()
 }
@@ -80,9 +98,10 @@ func (p TextProperty) GetType() PropertyType {
 }
 
 type NumberProperty struct {
-	ID     PropertyID   `json:"id,omitempty"`
-	Type   PropertyType `json:"type,omitempty"`
-	Number float64      `json:"number"`
+	ID   PropertyID   `json:"id,omitempty"`
+	Type PropertyType `json:"type,omitempty"`
+	// Number is nil if the property is empty. Send a nil Number to clear it.
+	Number *float64 `json:"number"`
 }
 
 func (p NumberProperty) GetID() string {
@@ -107,12 +126,37 @@ func (p SelectProperty) GetType() PropertyType {
 	return p.Type
 }
 
+// MarshalJSON encodes an empty Select as null, which clears the property.
+func (p SelectProperty) MarshalJSON() ([]byte, error) {
+	type alias SelectProperty
+	return json.Marshal(struct {
+		alias
+		Select *Option `json:"select"`
+	}{alias(p), optionOrNil(p.Select)})
+}
+
+func optionOrNil(o Option) *Option {
+	if o == (Option{}) {
+		return nil
+	}
+	return &o
+}
+
 type MultiSelectProperty struct {
 	ID          ObjectID     `json:"id,omitempty"`
 	Type        PropertyType `json:"type,omitempty"`
 	MultiSelect []Option     `json:"multi_select"`
 }
 
+// MarshalJSON encodes nil options as [], which clears the property.
+func (p MultiSelectProperty) MarshalJSON() ([]byte, error) {
+	type alias MultiSelectProperty
+	return json.Marshal(struct {
+		alias
+		MultiSelect []Option `json:"multi_select"`
+	}{alias(p), emptyIfNil(p.MultiSelect)})
+}
+
 func (p MultiSelectProperty) GetID() string {
 	return p.ID.String()
 }
@@ -154,14 +198,53 @@ type FormulaProperty struct {
 
 type FormulaType string
 
+// Formula is the result of a formula. Type tells which of the other fields
+// holds it; use the As methods to read it. A field is nil if the formula
+// has no result, e.g. a number formula of empty properties.
 type Formula struct {
 	Type    FormulaType `json:"type,omitempty"`
-	String  string      `json:"string,omitempty"`
-	Number  float64     `json:"number,omitempty"`
-	Boolean bool        `json:"boolean,omitempty"`
+	String  *string     `json:"string,omitempty"`
+	Number  *float64    `json:"number,omitempty"`
+	Boolean *bool       `json:"boolean,omitempty"`
 	Date    *DateObject `json:"date,omitempty"`
 }
 
+// AsString returns the result of a string formula. ok is false if the
+// formula is of another type or has no result.
+func (f Formula) AsString() (s string, ok bool) {
+	if f.Type != FormulaTypeString || f.String == nil {
+		return "", false
+	}
+	return *f.String, true
+}
+
+// AsNumber returns the result of a number formula. ok is false if the
+// formula is of another type or has no result.
+func (f Formula) AsNumber() (n float64, ok bool) {
+	if f.Type != FormulaTypeNumber || f.Number == nil {
+		return 0, false
+	}
+	return *f.Number, true
+}
+
+// AsBoolean returns the result of a boolean formula. ok is false if the
+// formula is of another type or has no result.
+func (f Formula) AsBoolean() (b bool, ok bool) {
+	if f.Type != FormulaTypeBoolean || f.Boolean == nil {
+		return false, false
+	}
+	return *f.Boolean, true
+}
+
+// AsDate returns the result of a date formula. ok is false if the formula
+// is of another type or has no result.
+func (f Formula) AsDate() (d *DateObject, ok bool) {
+	if f.Type != FormulaTypeDate || f.Date == nil {
+		return nil, false
+	}
+	return f.Date, true
+}
+
 func (p FormulaProperty) GetID() string {
 	return p.ID.String()
 }
@@ -176,6 +259,15 @@ type RelationProperty struct {
 	Relation []Relation   `json:"relation"`
 }
 
+// MarshalJSON encodes nil relations as [], which clears the property.
+func (p RelationProperty) MarshalJSON() ([]byte, error) {
+	type alias RelationProperty
+	return json.Marshal(struct {
+		alias
+		Relation []Relation `json:"relation"`
+	}{alias(p), emptyIfNil(p.Relation)})
+}
+
 type Relation struct {
 	ID PageID `json:"id"`
 }
@@ -196,11 +288,43 @@ type RollupProperty struct {
 
 type RollupType string
 
+// Rollup is the result of a rollup. Type tells which of the other fields
+// holds it; use the As methods to read it. The items of an array rollup are
+// property values of the type of the rolled up property, e.g. a
+// *TitleProperty or a *FormulaProperty each.
 type Rollup struct {
-	Type   RollupType    `json:"type,omitempty"`
-	Number float64       `json:"number,omitempty"`
-	Date   *DateObject   `json:"date,omitempty"`
-	Array  PropertyArray `json:"array,omitempty"`
+	Type     RollupType    `json:"type,omitempty"`
+	Number   *float64      `json:"number,omitempty"`
+	Date     *DateObject   `json:"date,omitempty"`
+	Array    PropertyArray `json:"array,omitempty"`
+	Function FunctionType  `json:"function,omitempty"`
+}
+
+// AsNumber returns the result of a number rollup. ok is false if the rollup
+// is of another type or has no result.
+func (r Rollup) AsNumber() (n float64, ok bool) {
+	if r.Type != RollupTypeNumber || r.Number == nil {
+		return 0, false
+	}
+	return *r.Number, true
+}
+
+// AsDate returns the result of a date rollup. ok is false if the rollup is
+// of another type or has no result.
+func (r Rollup) AsDate() (d *DateObject, ok bool) {
+	if r.Type != RollupTypeDate || r.Date == nil {
+		return nil, false
+	}
+	return r.Date, true
+}
+
+// AsArray returns the items of an array rollup. ok is false if the rollup is
+// of another type.
+func (r Rollup) AsArray() (items []Property, ok bool) {
+	if r.Type != RollupTypeArray {
+		return nil, false
+	}
+	return r.Array, true
 }
 
 func (p RollupProperty) GetID() string {
@@ -217,6 +341,15 @@ type PeopleProperty struct {
 	People []User       `json:"people"`
 }
 
+// MarshalJSON encodes nil people as [], which clears the property.
+func (p PeopleProperty) MarshalJSON() ([]byte, error) {
+	type alias PeopleProperty
+	return json.Marshal(struct {
+		alias
+		People []User `json:"people"`
+	}{alias(p), emptyIfNil(p.People)})
+}
+
 func (p PeopleProperty) GetID() string {
 	return p.ID.String()
 }
@@ -231,6 +364,15 @@ type FilesProperty struct {
 	Files []File       `json:"files"`
 }
 
+// MarshalJSON encodes nil files as [], which clears the property.
+func (p FilesProperty) MarshalJSON() ([]byte, error) {
+	type alias FilesProperty
+	return json.Marshal(struct {
+		alias
+		Files []File `json:"files"`
+	}{alias(p), emptyIfNil(p.Files)})
+}
+
 func (p FilesProperty) GetID() string {
 	return p.ID.String()
 }
@@ -267,6 +409,29 @@ func (p URLProperty) GetType() PropertyType {
 	return p.Type
 }
 
+// MarshalJSON encodes an empty URL as null, which clears the property.
+func (p URLProperty) MarshalJSON() ([]byte, error) {
+	type alias URLProperty
+	return json.Marshal(struct {
+		alias
+		URL *string `json:"url"`
+	}{alias(p), stringOrNil(p.URL)})
+}
+
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 type EmailProperty struct {
 	ID    PropertyID   `json:"id,omitempty"`
 	Type  PropertyType `json:"type,omitempty"`
@@ -281,6 +446,15 @@ func (p EmailProperty) GetType() PropertyType {
 	return p.Type
 }
 
+// MarshalJSON encodes an empty Email as null, which clears the property.
+func (p EmailProperty) MarshalJSON() ([]byte, error) {
+	type alias EmailProperty
+	return json.Marshal(struct {
+		alias
+		Email *string `json:"email"`
+	}{alias(p), stringOrNil(p.Email)})
+}
+
 type PhoneNumberProperty struct {
 	ID          ObjectID     `json:"id,omitempty"`
 	Type        PropertyType `json:"type,omitempty"`
@@ -295,6 +469,16 @@ func (p PhoneNumberProperty) GetType() PropertyType {
 	return p.Type
 }
 
+// MarshalJSON encodes an empty PhoneNumber as null, which clears the
+// property.
+func (p PhoneNumberProperty) MarshalJSON() ([]byte, error) {
+	type alias PhoneNumberProperty
+	return json.Marshal(struct {
+		alias
+		PhoneNumber *string `json:"phone_number"`
+	}{alias(p), stringOrNil(p.PhoneNumber)})
+}
+
 type CreatedTimeProperty struct {
 	ID          ObjectID     `json:"id,omitempty"`
 	Type        PropertyType `json:"type,omitempty"`
@@ -365,6 +549,15 @@ func (p StatusProperty) GetType() PropertyType {
 	return p.Type
 }
 
+// MarshalJSON encodes an empty Status as null.
+func (p StatusProperty) MarshalJSON() ([]byte, error) {
+	type alias StatusProperty
+	return json.Marshal(struct {
+		alias
+		Status *Option `json:"status"`
+	}{alias(p), optionOrNil(p.Status)})
+}
+
 type UniqueIDProperty struct {
 	ID       ObjectID     `json:"id,omitempty"`
 	Type     PropertyType `json:"type,omitempty"`
@@ -452,7 +645,8 @@ func parsePageProperties(raw map[string]any) (map[string]Property, error) {
 
 func decodeProperty(raw map[string]any) (Property, error) {
 	var p Property
-	switch PropertyType(raw["type"].(string)) {
+	typ, _ := raw["type"].(string)
+	switch PropertyType(typ) {
 	case PropertyTypeTitle:
 		p = &TitleProperty{}
 	case PropertyTypeRichText:
@@ -502,7 +696,7 @@ func decodeProperty(raw map[string]any) (Property, error) {
 	case PropertyTypeButton:
 		p = &ButtonProperty{}
 	default:
-		return nil, fmt.Errorf("unsupported property type: %s", raw["type"].(string))
+		return nil, fmt.Errorf("unsupported property type: %s", typ)
 	}
 
 	return p, nil
@@ -213,6 +213,7 @@ func (p FormulaPropertyConfig) GetID() PropertyID {
 }
 
 type RelationPropertyConfig struct {
+	ID       PropertyID         `json:"id,omitempty"`
 	Type     PropertyConfigType `json:"type"`
 	Relation RelationConfig     `json:"relation"`
 }
@@ -241,7 +242,7 @@ func (p RelationPropertyConfig) GetType() PropertyConfigType {
 }
 
 func (p RelationPropertyConfig) GetID() PropertyID {
-	return ""
+	return p.ID
 }
 
 type RollupPropertyConfig struct {
@@ -0,0 +1,240 @@
+package notionapi
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrPropertyNotFound is returned by the getters of Properties if there
+	// is no property with the given name or ID.
+	ErrPropertyNotFound = errors.New("property not found")
+	// ErrPropertyTypeMismatch is returned by the getters of Properties if the
+	// property is of another type than the getter reads.
+	ErrPropertyTypeMismatch = errors.New("property has a different type")
+)
+
+// Get returns the property with the given name or, if there is none, the
+// property with the given ID.
+func (p Properties) Get(nameOrID string) (Property, error) {
+	if property, ok := p[nameOrID]; ok {
+		return property, nil
+	}
+	if nameOrID != "" {
+		for _, property := range p {
+			if property.GetID() == nameOrID {
+				return property, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrPropertyNotFound, nameOrID)
+}
+
+// getProperty returns the property with the given name or ID as a T.
+func getProperty[T Property](p Properties, nameOrID string, want PropertyType) (T, error) {
+	var zero T
+	property, err := p.Get(nameOrID)
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := property.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: %q is a %s property, not %s", ErrPropertyTypeMismatch, nameOrID, property.GetType(), want)
+	}
+	return typed, nil
+}
+
+// Title returns the plain text of the title property with the given name or
+// ID.
+func (p Properties) Title(nameOrID string) (string, error) {
+	property, err := getProperty[*TitleProperty](p, nameOrID, PropertyTypeTitle)
+	if err != nil {
+		return "", err
+	}
+	return concatenateRichText(property.Title), nil
+}
+
+// RichText returns the plain text of the rich text property with the given
+// name or ID.
+func (p Properties) RichText(nameOrID string) (string, error) {
+	property, err := getProperty[*RichTextProperty](p, nameOrID, PropertyTypeRichText)
+	if err != nil {
+		return "", err
+	}
+	return concatenateRichText(property.RichText), nil
+}
+
+// Number returns the value of the number property with the given name or ID,
+// or nil if the property is empty.
+func (p Properties) Number(nameOrID string) (*float64, error) {
+	property, err := getProperty[*NumberProperty](p, nameOrID, PropertyTypeNumber)
+	if err != nil {
+		return nil, err
+	}
+	return property.Number, nil
+}
+
+// Select returns the name of the selected option of the select property with
+// the given name or ID, or an empty string if no option is selected.
+func (p Properties) Select(nameOrID string) (string, error) {
+	property, err := getProperty[*SelectProperty](p, nameOrID, PropertyTypeSelect)
+	if err != nil {
+		return "", err
+	}
+	return property.Select.Name, nil
+}
+
+// MultiSelect returns the names of the selected options of the multi-select
+// property with the given name or ID.
+func (p Properties) MultiSelect(nameOrID string) ([]string, error) {
+	property, err := getProperty[*MultiSelectProperty](p, nameOrID, PropertyTypeMultiSelect)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(property.MultiSelect))
+	for i, option := range property.MultiSelect {
+		names[i] = option.Name
+	}
+	return names, nil
+}
+
+// Status returns the name of the status of the status property with the
+// given name or ID.
+func (p Properties) Status(nameOrID string) (string, error) {
+	property, err := getProperty[*StatusProperty](p, nameOrID, PropertyTypeStatus)
+	if err != nil {
+		return "", err
+	}
+	return property.Status.Name, nil
+}
+
+// Date returns the value of the date property with the given name or ID, or
+// nil if the date is empty.
+func (p Properties) Date(nameOrID string) (*DateObject, error) {
+	property, err := getProperty[*DateProperty](p, nameOrID, PropertyTypeDate)
+	if err != nil {
+		return nil, err
+	}
+	return property.Date, nil
+}
+
+// Relation returns the IDs of the pages referenced by the relation property
+// with the given name or ID.
+func (p Properties) Relation(nameOrID string) ([]PageID, error) {
+	property, err := getProperty[*RelationProperty](p, nameOrID, PropertyTypeRelation)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]PageID, len(property.Relation))
+	for i, r := range property.Relation {
+		ids[i] = r.ID
+	}
+	return ids, nil
+}
+
+// People returns the users of the people property with the given name or ID.
+func (p Properties) People(nameOrID string) ([]User, error) {
+	property, err := getProperty[*PeopleProperty](p, nameOrID, PropertyTypePeople)
+	if err != nil {
+		return nil, err
+	}
+	return property.People, nil
+}
+
+// Files returns the files of the files property with the given name or ID.
+func (p Properties) Files(nameOrID string) ([]File, error) {
+	property, err := getProperty[*FilesProperty](p, nameOrID, PropertyTypeFiles)
+	if err != nil {
+		return nil, err
+	}
+	return property.Files, nil
+}
+
+// Checkbox returns the value of the checkbox property with the given name or
+// ID.
+func (p Properties) Checkbox(nameOrID string) (bool, error) {
+	property, err := getProperty[*CheckboxProperty](p, nameOrID, PropertyTypeCheckbox)
+	if err != nil {
+		return false, err
+	}
+	return property.Checkbox, nil
+}
+
+// URL returns the value of the URL property with the given name or ID.
+func (p Properties) URL(nameOrID string) (string, error) {
+	property, err := getProperty[*URLProperty](p, nameOrID, PropertyTypeURL)
+	if err != nil {
+		return "", err
+	}
+	return property.URL, nil
+}
+
+// Email returns the value of the email property with the given name or ID.
+func (p Properties) Email(nameOrID string) (string, error) {
+	property, err := getProperty[*EmailProperty](p, nameOrID, PropertyTypeEmail)
+	if err != nil {
+		return "", err
+	}
+	return property.Email, nil
+}
+
+// PhoneNumber returns the value of the phone number property with the given
+// name or ID.
+func (p Properties) PhoneNumber(nameOrID string) (string, error) {
+	property, err := getProperty[*PhoneNumberProperty](p, nameOrID, PropertyTypePhoneNumber)
+	if err != nil {
+		return "", err
+	}
+	return property.PhoneNumber, nil
+}
+
+// Formula returns the result of the formula property with the given name or
+// ID.
+func (p Properties) Formula(nameOrID string) (Formula, error) {
+	property, err := getProperty[*FormulaProperty](p, nameOrID, PropertyTypeFormula)
+	if err != nil {
+		return Formula{}, err
+	}
+	return property.Formula, nil
+}
+
+// Rollup returns the result of the rollup property with the given name or
+// ID.
+func (p Properties) Rollup(nameOrID string) (Rollup, error) {
+	property, err := getProperty[*RollupProperty](p, nameOrID, PropertyTypeRollup)
+	if err != nil {
+		return Rollup{}, err
+	}
+	return property.Rollup, nil
+}
+
+// UniqueID returns the value of the unique ID property with the given name or
+// ID.
+func (p Properties) UniqueID(nameOrID string) (UniqueID, error) {
+	property, err := getProperty[*UniqueIDProperty](p, nameOrID, PropertyTypeUniqueID)
+	if err != nil {
+		return UniqueID{}, err
+	}
+	return property.UniqueID, nil
+}
+
+// CreatedTime returns the value of the created time property with the given
+// name or ID.
+func (p Properties) CreatedTime(nameOrID string) (time.Time, error) {
+	property, err := getProperty[*CreatedTimeProperty](p, nameOrID, PropertyTypeCreatedTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return property.CreatedTime, nil
+}
+
+// LastEditedTime returns the value of the last edited time property with the
+// given name or ID.
+func (p Properties) LastEditedTime(nameOrID string) (time.Time, error) {
+	property, err := getProperty[*LastEditedTimeProperty](p, nameOrID, PropertyTypeLastEditedTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return property.LastEditedTime, nil
+}
@@ -0,0 +1,76 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestPropertiesGetters(t *testing.T) {
+	data, err := os.ReadFile("testdata/page_get.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var page notionapi.Page
+	if err := json.Unmarshal(data, &page); err != nil {
+		t.Fatal(err)
+	}
+	props := page.Properties
+
+	t.Run("by name", func(t *testing.T) {
+		title, err := props.Title("Name")
+		if err != nil || title != "Hello" {
+			t.Errorf("Title() = %q, %v", title, err)
+		}
+		text, err := props.RichText("SomeColumn")
+		if err != nil || text != "some text" {
+			t.Errorf("RichText() = %q, %v", text, err)
+		}
+		tags, err := props.MultiSelect("Tags")
+		if err != nil || !reflect.DeepEqual(tags, []string{"tag"}) {
+			t.Errorf("MultiSelect() = %v, %v", tags, err)
+		}
+		people, err := props.People("Some another column")
+		if err != nil || len(people) != 1 || people[0].ID != "some_id" {
+			t.Errorf("People() = %v, %v", people, err)
+		}
+		rollup, err := props.Rollup("RollupArray")
+		if err != nil || rollup.Type != notionapi.RollupTypeArray || len(rollup.Array) != 2 {
+			t.Errorf("Rollup() = %v, %v", rollup, err)
+		}
+	})
+
+	t.Run("by id", func(t *testing.T) {
+		tags, err := props.MultiSelect(";s|V")
+		if err != nil || !reflect.DeepEqual(tags, []string{"tag"}) {
+			t.Errorf("MultiSelect() = %v, %v", tags, err)
+		}
+		files, err := props.Files("files")
+		if err != nil || len(files) != 1 || files[0].External.URL != "https://google.com" {
+			t.Errorf("Files() = %v, %v", files, err)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		if _, err := props.Number("Missing"); !errors.Is(err, notionapi.ErrPropertyNotFound) {
+			t.Errorf("Number() error = %v, want ErrPropertyNotFound", err)
+		}
+		if _, err := props.Select(""); !errors.Is(err, notionapi.ErrPropertyNotFound) {
+			t.Errorf("Select() error = %v, want ErrPropertyNotFound", err)
+		}
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		_, err := props.Date("Name")
+		if !errors.Is(err, notionapi.ErrPropertyTypeMismatch) {
+			t.Fatalf("Date() error = %v, want ErrPropertyTypeMismatch", err)
+		}
+		if want := `property has a different type: "Name" is a title property, not date`; err.Error() != want {
+			t.Errorf("Date() error = %q, want %q", err, want)
+		}
+	})
+}
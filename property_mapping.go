@@ -0,0 +1,544 @@
+package notionapi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// MarshalProperties converts v, a struct or a pointer to a struct, into page
+// properties. Only fields with a notion tag are converted. The tag holds the
+// name of the property and optionally its type:
+//
+//	type Ticket struct {
+//		Title  string   `notion:"Name,title"`
+//		Points float64  `notion:"Points"`
+//		Tags   []string `notion:"Tags,multi_select"`
+//	}
+//
+// Without a type it is derived from the Go type of the field: strings are
+// rich text, numbers are numbers, bools are checkboxes, time.Time, Date and
+// DateObject are dates, []string is a multi-select, []PageID is a relation
+// and []UserID is a people property. Set the type explicitly for titles,
+// selects, statuses, URLs, emails and phone numbers.
+//
+// Besides the types above, fields can hold the values of the corresponding
+// property structs, e.g. []RichText for titles, Option for selects, []User
+// for people or []File for files, where []string holds external file URLs.
+// Pointers to all of these types are supported; nil pointers produce empty
+// properties, which clear the property when sent. Empty strings clear
+// selects, statuses, URLs, emails and phone numbers as well. Fields of
+// read-only property types, like formulas or the created time, are ignored.
+func MarshalProperties(v any) (Properties, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, errors.New("notionapi: MarshalProperties of nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("notionapi: MarshalProperties of non-struct type %s", rv.Type())
+	}
+	fields, err := propertyFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	result := Properties{}
+	for _, f := range fields {
+		if !isWritablePropertyType(f.typ) {
+			continue
+		}
+		p, err := marshalProperty(f.typ, rv.FieldByIndex(f.index))
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", f.name, err)
+		}
+		result[f.name] = p
+	}
+	return result, nil
+}
+
+// UnmarshalPage stores the properties of page in v, a pointer to a struct
+// tagged as described for MarshalProperties. Fields whose property is
+// missing from the page are left unchanged. Values of read-only properties
+// are stored as well: formulas and rollups in Formula and Rollup fields or
+// in fields matching their result, created and last edited times in
+// time.Time fields, users in User or UserID fields, unique IDs in UniqueID,
+// string or int fields and verifications in Verification or string fields.
+//
+// If a field sets the property type explicitly, it has to match the type of
+// the property.
+func UnmarshalPage(page *Page, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("notionapi: UnmarshalPage requires a non-nil pointer")
+	}
+	rv = rv.Elem()
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("notionapi: UnmarshalPage of non-struct type %s", rv.Type())
+	}
+	fields, err := propertyFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		p, ok := page.Properties[f.name]
+		if !ok {
+			continue
+		}
+		if f.explicit && !samePropertyType(p.GetType(), f.typ) {
+			return fmt.Errorf("property %q: is of type %s, not %s", f.name, p.GetType(), f.typ)
+		}
+		if err := unmarshalProperty(p, rv.FieldByIndex(f.index)); err != nil {
+			return fmt.Errorf("property %q: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// propertyField is a struct field tagged with the property it holds.
+type propertyField struct {
+	index []int
+	name  string
+	typ   PropertyType
+	// explicit is true if the type is set in the tag.
+	explicit bool
+}
+
+func propertyFields(t reflect.Type) ([]propertyField, error) {
+	var fields []propertyField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("notion")
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+		name, typ, _ := strings.Cut(tag, ",")
+		f := propertyField{index: sf.Index, name: name, typ: PropertyType(typ), explicit: typ != ""}
+		if f.name == "" {
+			f.name = sf.Name
+		}
+		if f.explicit {
+			if _, err := decodeProperty(map[string]any{"type": typ}); err != nil {
+				return nil, fmt.Errorf("field %s: %w", sf.Name, err)
+			}
+		} else if f.typ = inferPropertyType(sf.Type); f.typ == "" {
+			return nil, fmt.Errorf("field %s: cannot derive a property type from %s", sf.Name, sf.Type)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	dateType       = reflect.TypeOf(Date{})
+	dateObjectType = reflect.TypeOf(DateObject{})
+	richTextType   = reflect.TypeOf([]RichText(nil))
+	optionType     = reflect.TypeOf(Option{})
+	pageIDType     = reflect.TypeOf(PageID(""))
+	userIDType     = reflect.TypeOf(UserID(""))
+)
+
+func inferPropertyType(t reflect.Type) PropertyType {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType, dateType, dateObjectType:
+		return PropertyTypeDate
+	case richTextType:
+		return PropertyTypeRichText
+	case optionType:
+		return PropertyTypeSelect
+	case reflect.TypeOf([]Option(nil)):
+		return PropertyTypeMultiSelect
+	case reflect.TypeOf([]Relation(nil)):
+		return PropertyTypeRelation
+	case reflect.TypeOf([]User(nil)):
+		return PropertyTypePeople
+	case reflect.TypeOf([]File(nil)):
+		return PropertyTypeFiles
+	}
+	switch {
+	case t.Kind() == reflect.String:
+		return PropertyTypeRichText
+	case isNumberKind(t.Kind()):
+		return PropertyTypeNumber
+	case t.Kind() == reflect.Bool:
+		return PropertyTypeCheckbox
+	case t.Kind() == reflect.Slice && t.Elem() == pageIDType:
+		return PropertyTypeRelation
+	case t.Kind() == reflect.Slice && t.Elem() == userIDType:
+		return PropertyTypePeople
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		return PropertyTypeMultiSelect
+	}
+	return ""
+}
+
+func samePropertyType(a, b PropertyType) bool {
+	text := func(t PropertyType) bool { return t == PropertyTypeText || t == PropertyTypeRichText }
+	return a == "" || a == b || text(a) && text(b)
+}
+
+func marshalProperty(typ PropertyType, v reflect.Value) (Property, error) {
+	isNilPointer := v.Kind() == reflect.Ptr && v.IsNil()
+	if v.Kind() == reflect.Ptr {
+		if isNilPointer {
+			v = reflect.Zero(v.Type().Elem())
+		} else {
+			v = v.Elem()
+		}
+	}
+
+	switch typ {
+	case PropertyTypeTitle, PropertyTypeRichText, PropertyTypeText:
+		rt, ok := valueAs[[]RichText](v)
+		if !ok {
+			s, ok := valueAs[string](v)
+			if !ok {
+				return nil, cannotMarshal(v, typ)
+			}
+			rt = textRichText(s)
+		}
+		if rt == nil {
+			rt = []RichText{}
+		}
+		if typ == PropertyTypeTitle {
+			return &TitleProperty{Type: typ, Title: rt}, nil
+		}
+		return &RichTextProperty{Type: PropertyTypeRichText, RichText: rt}, nil
+	case PropertyTypeNumber:
+		if n, ok := valueAs[float64](v); ok {
+			if isNilPointer {
+				return &NumberProperty{Type: typ}, nil
+			}
+			return &NumberProperty{Type: typ, Number: &n}, nil
+		}
+	case PropertyTypeSelect, PropertyTypeStatus:
+		option, ok := valueAs[Option](v)
+		if !ok {
+			name, ok := valueAs[string](v)
+			if !ok {
+				return nil, cannotMarshal(v, typ)
+			}
+			option = Option{Name: name}
+		}
+		if typ == PropertyTypeStatus {
+			return &StatusProperty{Type: typ, Status: option}, nil
+		}
+		return &SelectProperty{Type: typ, Select: option}, nil
+	case PropertyTypeMultiSelect:
+		if options, ok := valueAs[[]Option](v); ok {
+			return &MultiSelectProperty{Type: typ, MultiSelect: nonNil(options)}, nil
+		}
+		if names, ok := valueAs[[]string](v); ok {
+			options := make([]Option, len(names))
+			for i, name := range names {
+				options[i] = Option{Name: name}
+			}
+			return &MultiSelectProperty{Type: typ, MultiSelect: options}, nil
+		}
+	case PropertyTypeDate:
+		if d, ok := valueAs[DateObject](v); ok {
+			if d.Start == nil {
+				return &DateProperty{Type: typ}, nil
+			}
+			return &DateProperty{Type: typ, Date: &d}, nil
+		}
+		if t, ok := valueAs[time.Time](v); ok {
+			if t.IsZero() {
+				return &DateProperty{Type: typ}, nil
+			}
+			start := Date(t)
+			return &DateProperty{Type: typ, Date: &DateObject{Start: &start}}, nil
+		}
+	case PropertyTypeRelation:
+		if relations, ok := valueAs[[]Relation](v); ok {
+			return &RelationProperty{Type: typ, Relation: nonNil(relations)}, nil
+		}
+		if ids, ok := valueAs[[]PageID](v); ok {
+			relations := make([]Relation, len(ids))
+			for i, id := range ids {
+				relations[i] = Relation{ID: id}
+			}
+			return &RelationProperty{Type: typ, Relation: relations}, nil
+		}
+	case PropertyTypePeople:
+		if users, ok := valueAs[[]User](v); ok {
+			return &PeopleProperty{Type: typ, People: nonNil(users)}, nil
+		}
+		if ids, ok := valueAs[[]UserID](v); ok {
+			users := make([]User, len(ids))
+			for i, id := range ids {
+				users[i] = User{ID: id}
+			}
+			return &PeopleProperty{Type: typ, People: users}, nil
+		}
+	case PropertyTypeFiles:
+		if files, ok := valueAs[[]File](v); ok {
+			return &FilesProperty{Type: typ, Files: nonNil(files)}, nil
+		}
+		if urls, ok := valueAs[[]string](v); ok {
+			files := make([]File, len(urls))
+			for i, url := range urls {
+				files[i] = File{Name: url, Type: FileTypeExternal, External: &FileObject{URL: url}}
+			}
+			return &FilesProperty{Type: typ, Files: files}, nil
+		}
+	case PropertyTypeCheckbox:
+		if b, ok := valueAs[bool](v); ok {
+			return &CheckboxProperty{Type: typ, Checkbox: b}, nil
+		}
+	case PropertyTypeURL:
+		if s, ok := valueAs[string](v); ok {
+			return &URLProperty{Type: typ, URL: s}, nil
+		}
+	case PropertyTypeEmail:
+		if s, ok := valueAs[string](v); ok {
+			return &EmailProperty{Type: typ, Email: s}, nil
+		}
+	case PropertyTypePhoneNumber:
+		if s, ok := valueAs[string](v); ok {
+			return &PhoneNumberProperty{Type: typ, PhoneNumber: s}, nil
+		}
+	}
+	return nil, cannotMarshal(v, typ)
+}
+
+func cannotMarshal(v reflect.Value, typ PropertyType) error {
+	return fmt.Errorf("cannot convert %s to a %s property", v.Type(), typ)
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
+
+func unmarshalProperty(p Property, field reflect.Value) error {
+	switch p := p.(type) {
+	case *TitleProperty:
+		return setField(field, false, p.Title, concatenateRichText(p.Title))
+	case *RichTextProperty:
+		return setField(field, false, p.RichText, concatenateRichText(p.RichText))
+	case *TextProperty:
+		return setField(field, false, p.Text, concatenateRichText(p.Text))
+	case *NumberProperty:
+		if p.Number == nil {
+			return setField(field, true)
+		}
+		return setField(field, false, *p.Number)
+	case *SelectProperty:
+		return setField(field, p.Select.Name == "", p.Select, p.Select.Name)
+	case *StatusProperty:
+		return setField(field, p.Status.Name == "", p.Status, p.Status.Name)
+	case *MultiSelectProperty:
+		names := make([]string, len(p.MultiSelect))
+		for i, option := range p.MultiSelect {
+			names[i] = option.Name
+		}
+		return setField(field, false, p.MultiSelect, names)
+	case *DateProperty:
+		return setDate(field, p.Date)
+	case *FormulaProperty:
+		if setField(field, false, p.Formula) == nil {
+			return nil
+		}
+		switch p.Formula.Type {
+		case FormulaTypeString:
+			s, ok := p.Formula.AsString()
+			return setField(field, !ok, s)
+		case FormulaTypeNumber:
+			n, ok := p.Formula.AsNumber()
+			return setField(field, !ok, n)
+		case FormulaTypeBoolean:
+			b, ok := p.Formula.AsBoolean()
+			return setField(field, !ok, b)
+		case FormulaTypeDate:
+			return setDate(field, p.Formula.Date)
+		}
+		return setField(field, true)
+	case *RelationProperty:
+		ids := make([]PageID, len(p.Relation))
+		for i, r := range p.Relation {
+			ids[i] = r.ID
+		}
+		return setField(field, false, p.Relation, ids)
+	case *RollupProperty:
+		if setField(field, false, p.Rollup) == nil {
+			return nil
+		}
+		switch p.Rollup.Type {
+		case RollupTypeNumber:
+			n, ok := p.Rollup.AsNumber()
+			return setField(field, !ok, n)
+		case RollupTypeDate:
+			return setDate(field, p.Rollup.Date)
+		case RollupTypeArray:
+			return setField(field, false, p.Rollup.Array)
+		}
+		return setField(field, true)
+	case *PeopleProperty:
+		ids := make([]UserID, len(p.People))
+		for i, u := range p.People {
+			ids[i] = u.ID
+		}
+		return setField(field, false, p.People, ids)
+	case *FilesProperty:
+		urls := make([]string, len(p.Files))
+		for i, f := range p.Files {
+			switch {
+			case f.External != nil:
+				urls[i] = f.External.URL
+			case f.File != nil:
+				urls[i] = f.File.URL
+			}
+		}
+		return setField(field, false, p.Files, urls)
+	case *CheckboxProperty:
+		return setField(field, false, p.Checkbox)
+	case *URLProperty:
+		return setField(field, false, p.URL)
+	case *EmailProperty:
+		return setField(field, false, p.Email)
+	case *PhoneNumberProperty:
+		return setField(field, false, p.PhoneNumber)
+	case *CreatedTimeProperty:
+		return setField(field, false, p.CreatedTime)
+	case *LastEditedTimeProperty:
+		return setField(field, false, p.LastEditedTime)
+	case *CreatedByProperty:
+		return setField(field, false, p.CreatedBy, p.CreatedBy.ID)
+	case *LastEditedByProperty:
+		return setField(field, false, p.LastEditedBy, p.LastEditedBy.ID)
+	case *UniqueIDProperty:
+		return setField(field, false, p.UniqueID, p.UniqueID.String(), p.UniqueID.Number)
+	case *VerificationProperty:
+		return setField(field, false, p.Verification, string(p.Verification.State))
+	case *ButtonProperty:
+		return nil
+	}
+	return fmt.Errorf("unsupported property %T", p)
+}
+
+func setDate(field reflect.Value, d *DateObject) error {
+	if d == nil || d.Start == nil {
+		return setField(field, true)
+	}
+	return setField(field, false, *d, time.Time(*d.Start))
+}
+
+// setField stores the first of candidates that can be converted to the type
+// of field. If empty is true, field is set to its zero value instead.
+// Pointer fields are allocated as needed and set to nil if empty is true.
+func setField(field reflect.Value, empty bool, candidates ...any) error {
+	t := field.Type()
+	if empty {
+		field.Set(reflect.Zero(t))
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		elem := reflect.New(t.Elem())
+		if err := setField(elem.Elem(), false, candidates...); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	}
+	for _, c := range candidates {
+		if v, ok := convertValue(reflect.ValueOf(c), t); ok {
+			field.Set(v)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot store %T in a field of type %s", candidates[0], t)
+}
+
+// valueAs converts v to T, see convertValue.
+func valueAs[T any](v reflect.Value) (T, bool) {
+	var zero T
+	converted, ok := convertValue(v, reflect.TypeOf(zero))
+	if !ok {
+		return zero, false
+	}
+	return converted.Interface().(T), true
+}
+
+// convertValue converts v to t if v is assignable to t, if both are strings,
+// bools or numbers, or if both are slices with convertible elements. Types
+// based on the same struct, like time.Time and Date, are converted as well.
+// Numbers are only converted if t can hold their value, see numberFits.
+func convertValue(v reflect.Value, t reflect.Type) (reflect.Value, bool) {
+	switch {
+	case v.Type().AssignableTo(t):
+		return v, true
+	case v.Kind() == reflect.Slice && t.Kind() == reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(t), true
+		}
+		result := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, ok := convertValue(v.Index(i), t.Elem())
+			if !ok {
+				return reflect.Value{}, false
+			}
+			result.Index(i).Set(elem)
+		}
+		return result, true
+	case isNumberKind(v.Kind()) && isNumberKind(t.Kind()):
+		if !numberFits(v, t) {
+			return reflect.Value{}, false
+		}
+		return v.Convert(t), true
+	case v.Kind() == t.Kind() && v.Kind() != reflect.Ptr && v.Type().ConvertibleTo(t):
+		return v.Convert(t), true
+	}
+	return reflect.Value{}, false
+}
+
+// numberFits reports whether the number v can be converted to the integer
+// type t without changing its value: fractions, negative numbers for unsigned
+// types and values out of the range of t do not fit. Any number fits in a
+// float type.
+func numberFits(v reflect.Value, t reflect.Type) bool {
+	target := reflect.New(t).Elem()
+	switch {
+	case target.CanInt():
+		switch {
+		case v.CanInt():
+			return !target.OverflowInt(v.Int())
+		case v.CanUint():
+			return v.Uint() <= math.MaxInt64 && !target.OverflowInt(int64(v.Uint()))
+		}
+		f := v.Float()
+		return f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 && !target.OverflowInt(int64(f))
+	case target.CanUint():
+		switch {
+		case v.CanInt():
+			return v.Int() >= 0 && !target.OverflowUint(uint64(v.Int()))
+		case v.CanUint():
+			return !target.OverflowUint(v.Uint())
+		}
+		f := v.Float()
+		return f == math.Trunc(f) && f >= 0 && f < math.MaxUint64 && !target.OverflowUint(uint64(f))
+	}
+	return true
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
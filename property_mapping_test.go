@@ -0,0 +1,187 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tenz-io/notionapi"
+)
+
+type ticket struct {
+	Title    string             `notion:"Name,title"`
+	Points   float64            `notion:"Points"`
+	Estimate *int               `notion:"Estimate"`
+	Tags     []string           `notion:"Tags,multi_select"`
+	State    string             `notion:"State,status"`
+	Priority *string            `notion:"Priority,select"`
+	Done     bool               `notion:"Done"`
+	Due      *time.Time         `notion:"Due"`
+	Blocks   []notionapi.PageID `notion:"Blocks"`
+	Owners   []notionapi.UserID `notion:"Owners"`
+	Link     string             `notion:"Link,url"`
+	Notes    []notionapi.RichText
+	Ignored  string    `notion:"-"`
+	Created  time.Time `notion:"Created,created_time"`
+	Key      string    `notion:"Key,unique_id"`
+	Score    float64   `notion:"Score,formula"`
+}
+
+func TestMarshalProperties(t *testing.T) {
+	due := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	v := ticket{
+		Title:   "Fix login",
+		Points:  3,
+		Tags:    []string{"bug", "auth"},
+		State:   "In progress",
+		Done:    true,
+		Due:     &due,
+		Blocks:  []notionapi.PageID{"p1"},
+		Owners:  []notionapi.UserID{"u1"},
+		Link:    "https://example.com",
+		Created: due,
+		Key:     "T-1",
+	}
+	got, err := notionapi.MarshalProperties(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+		"Blocks":{"type":"relation","relation":[{"id":"p1"}]},
+		"Done":{"type":"checkbox","checkbox":true},
+		"Due":{"type":"date","date":{"start":"2024-03-01T00:00:00Z","end":null}},
+		"Estimate":{"type":"number","number":null},
+		"Link":{"type":"url","url":"https://example.com"},
+		"Name":{"type":"title","title":[{"type":"text","text":{"content":"Fix login"}}]},
+		"Owners":{"type":"people","people":[{"id":"u1"}]},
+		"Points":{"type":"number","number":3},
+		"Priority":{"type":"select","select":null},
+		"State":{"type":"status","status":{"name":"In progress"}},
+		"Tags":{"type":"multi_select","multi_select":[{"name":"bug"},{"name":"auth"}]}
+	}`
+	var wantMap, gotMap map[string]any
+	if err := json.Unmarshal([]byte(want), &wantMap); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(mustJSON(t, got)), &gotMap); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotMap, wantMap) {
+		t.Errorf("MarshalProperties() = %s", mustJSON(t, got))
+	}
+
+	if _, err := notionapi.MarshalProperties(struct {
+		Bad struct{} `notion:"Bad"`
+	}{}); err == nil {
+		t.Error("MarshalProperties() of an unsupported field type succeeded")
+	}
+	if _, err := notionapi.MarshalProperties(struct {
+		Bad int `notion:"Bad,url"`
+	}{}); err == nil {
+		t.Error("MarshalProperties() of an int url succeeded")
+	}
+}
+
+func TestUnmarshalPage(t *testing.T) {
+	page := &notionapi.Page{}
+	err := json.Unmarshal([]byte(`{"properties":{
+		"Name":{"id":"title","type":"title","title":[{"type":"text","text":{"content":"Fix login"},"plain_text":"Fix login"}]},
+		"Points":{"id":"a","type":"number","number":3},
+		"Estimate":{"id":"b","type":"number","number":5},
+		"Tags":{"id":"c","type":"multi_select","multi_select":[{"id":"1","name":"bug","color":"red"}]},
+		"State":{"id":"d","type":"status","status":{"id":"2","name":"Done","color":"green"}},
+		"Priority":{"id":"e","type":"select","select":null},
+		"Done":{"id":"f","type":"checkbox","checkbox":true},
+		"Due":{"id":"g","type":"date","date":{"start":"2024-03-01","end":null}},
+		"Blocks":{"id":"h","type":"relation","relation":[{"id":"p1"},{"id":"p2"}]},
+		"Owners":{"id":"i","type":"people","people":[{"object":"user","id":"u1"}]},
+		"Link":{"id":"j","type":"url","url":"https://example.com"},
+		"Notes":{"id":"k","type":"rich_text","rich_text":[{"type":"text","text":{"content":"n"},"plain_text":"n"}]},
+		"Created":{"id":"l","type":"created_time","created_time":"2024-01-02T03:04:05Z"},
+		"Key":{"id":"m","type":"unique_id","unique_id":{"prefix":"T","number":7}},
+		"Score":{"id":"n","type":"formula","formula":{"type":"number","number":1.5}}
+	}}`), page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got ticket
+	if err := notionapi.UnmarshalPage(page, &got); err != nil {
+		t.Fatal(err)
+	}
+	estimate := 5
+	due := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	want := ticket{
+		Title:    "Fix login",
+		Points:   3,
+		Estimate: &estimate,
+		Tags:     []string{"bug"},
+		State:    "Done",
+		Done:     true,
+		Due:      &due,
+		Blocks:   []notionapi.PageID{"p1", "p2"},
+		Owners:   []notionapi.UserID{"u1"},
+		Link:     "https://example.com",
+		Created:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Key:      "T-7",
+		Score:    1.5,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UnmarshalPage() = %+v, want %+v", got, want)
+	}
+
+	var wrongType struct {
+		Points string `notion:"Points,rich_text"`
+	}
+	if err := notionapi.UnmarshalPage(page, &wrongType); err == nil {
+		t.Error("UnmarshalPage() into a field of another property type succeeded")
+	}
+}
+
+func TestUnmarshalPageNumbers(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		field   any
+		wantErr bool
+	}{
+		{name: "integer into int", number: "3", field: new(struct {
+			N int `notion:"N"`
+		})},
+		{name: "integer into uint8", number: "255", field: new(struct {
+			N uint8 `notion:"N"`
+		})},
+		{name: "fraction into float", number: "3.7", field: new(struct {
+			N float32 `notion:"N"`
+		})},
+		{name: "fraction into int", number: "3.7", wantErr: true, field: new(struct {
+			N int `notion:"N"`
+		})},
+		{name: "negative into uint", number: "-1", wantErr: true, field: new(struct {
+			N uint `notion:"N"`
+		})},
+		{name: "overflowing int8", number: "128", wantErr: true, field: new(struct {
+			N int8 `notion:"N"`
+		})},
+		{name: "overflowing int64", number: "1e20", wantErr: true, field: new(struct {
+			N *int64 `notion:"N"`
+		})},
+		{name: "overflowing uint16", number: "65536", wantErr: true, field: new(struct {
+			N uint16 `notion:"N"`
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := &notionapi.Page{}
+			err := json.Unmarshal([]byte(`{"properties":{"N":{"id":"a","type":"number","number":`+tt.number+`}}}`), page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := notionapi.UnmarshalPage(page, tt.field); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalPage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestFormulaRollupResults(t *testing.T) {
+	data, err := os.ReadFile("testdata/page_formula_rollup.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var page notionapi.Page
+	if err := json.Unmarshal(data, &page); err != nil {
+		t.Fatal(err)
+	}
+	props := page.Properties
+
+	formula := func(t *testing.T, name string) notionapi.Formula {
+		t.Helper()
+		f, err := props.Formula(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return f
+	}
+	rollup := func(t *testing.T, name string) notionapi.Rollup {
+		t.Helper()
+		r, err := props.Rollup(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	date := func(s string) *notionapi.Date {
+		tm, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := notionapi.Date(tm)
+		return &d
+	}
+
+	t.Run("formula string", func(t *testing.T) {
+		if s, ok := formula(t, "FormulaString").AsString(); !ok || s != "done" {
+			t.Errorf("AsString() = %q, %v", s, ok)
+		}
+		if s, ok := formula(t, "FormulaEmptyString").AsString(); ok {
+			t.Errorf("AsString() of empty result = %q, %v", s, ok)
+		}
+	})
+
+	t.Run("formula number", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			want   float64
+			wantOK bool
+		}{
+			{"FormulaNumber", 12.5, true},
+			{"FormulaZero", 0, true},
+			{"FormulaNull", 0, false},
+		}
+		for _, tt := range tests {
+			if n, ok := formula(t, tt.name).AsNumber(); n != tt.want || ok != tt.wantOK {
+				t.Errorf("%s: AsNumber() = %v, %v, want %v, %v", tt.name, n, ok, tt.want, tt.wantOK)
+			}
+		}
+	})
+
+	t.Run("formula boolean", func(t *testing.T) {
+		if b, ok := formula(t, "FormulaTrue").AsBoolean(); !ok || !b {
+			t.Errorf("AsBoolean() = %v, %v", b, ok)
+		}
+		if b, ok := formula(t, "FormulaFalse").AsBoolean(); !ok || b {
+			t.Errorf("AsBoolean() = %v, %v, want false, true", b, ok)
+		}
+	})
+
+	t.Run("formula date", func(t *testing.T) {
+		want := &notionapi.DateObject{Start: date("2024-02-01")}
+		if d, ok := formula(t, "FormulaDate").AsDate(); !ok || !reflect.DeepEqual(d, want) {
+			t.Errorf("AsDate() = %v, %v", d, ok)
+		}
+	})
+
+	t.Run("formula of another type", func(t *testing.T) {
+		f := formula(t, "FormulaFalse")
+		if _, ok := f.AsNumber(); ok {
+			t.Error("AsNumber() of boolean formula is ok")
+		}
+		if _, ok := f.AsString(); ok {
+			t.Error("AsString() of boolean formula is ok")
+		}
+		if _, ok := f.AsDate(); ok {
+			t.Error("AsDate() of boolean formula is ok")
+		}
+	})
+
+	t.Run("rollup number", func(t *testing.T) {
+		r := rollup(t, "RollupNumber")
+		if n, ok := r.AsNumber(); !ok || n != 3 || r.Function != notionapi.FunctionSum {
+			t.Errorf("AsNumber() = %v, %v, function %s", n, ok, r.Function)
+		}
+		if n, ok := rollup(t, "RollupZero").AsNumber(); !ok || n != 0 {
+			t.Errorf("AsNumber() = %v, %v, want 0, true", n, ok)
+		}
+	})
+
+	t.Run("rollup date", func(t *testing.T) {
+		want := &notionapi.DateObject{Start: date("2024-01-15"), End: date("2024-01-31")}
+		if d, ok := rollup(t, "RollupDate").AsDate(); !ok || !reflect.DeepEqual(d, want) {
+			t.Errorf("AsDate() = %v, %v", d, ok)
+		}
+	})
+
+	t.Run("rollup array", func(t *testing.T) {
+		items, ok := rollup(t, "RollupArray").AsArray()
+		if !ok {
+			t.Fatal("AsArray() is not ok")
+		}
+		want := []notionapi.Property{
+			&notionapi.TitleProperty{
+				Type: notionapi.PropertyTypeTitle,
+				Title: []notionapi.RichText{{
+					Type:      notionapi.RichTextTypeText,
+					Text:      &notionapi.Text{Content: "First"},
+					PlainText: "First",
+				}},
+			},
+			&notionapi.FormulaProperty{
+				Type:    notionapi.PropertyTypeFormula,
+				Formula: notionapi.Formula{Type: notionapi.FormulaTypeBoolean, Boolean: ptr(false)},
+			},
+			&notionapi.CheckboxProperty{Type: notionapi.PropertyTypeCheckbox, Checkbox: true},
+			&notionapi.NumberProperty{Type: notionapi.PropertyTypeNumber, Number: ptr(0.0)},
+		}
+		if !reflect.DeepEqual(items, want) {
+			t.Errorf("AsArray() = %#v, want %#v", items, want)
+		}
+	})
+
+	t.Run("rollup without result", func(t *testing.T) {
+		for _, name := range []string{"RollupIncomplete", "RollupUnsupported"} {
+			r := rollup(t, name)
+			if _, ok := r.AsNumber(); ok {
+				t.Errorf("%s: AsNumber() is ok", name)
+			}
+			if _, ok := r.AsDate(); ok {
+				t.Errorf("%s: AsDate() is ok", name)
+			}
+			if _, ok := r.AsArray(); ok {
+				t.Errorf("%s: AsArray() is ok", name)
+			}
+		}
+		if typ := rollup(t, "RollupIncomplete").Type; typ != notionapi.RollupTypeIncomplete {
+			t.Errorf("Type = %s, want incomplete", typ)
+		}
+	})
+}
+
+func TestFormulaMarshalKeepsZeroResults(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{
+			name: "false",
+			v:    notionapi.Formula{Type: notionapi.FormulaTypeBoolean, Boolean: ptr(false)},
+			want: `{"type":"boolean","boolean":false}`,
+		},
+		{
+			name: "zero",
+			v:    notionapi.Formula{Type: notionapi.FormulaTypeNumber, Number: ptr(0.0)},
+			want: `{"type":"number","number":0}`,
+		},
+		{
+			name: "empty string",
+			v:    notionapi.Formula{Type: notionapi.FormulaTypeString, String: ptr("")},
+			want: `{"type":"string","string":""}`,
+		},
+		{
+			name: "zero rollup",
+			v:    notionapi.Rollup{Type: notionapi.RollupTypeNumber, Number: ptr(0.0), Function: notionapi.FunctionCountAll},
+			want: `{"type":"number","number":0,"function":"count_all"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+package notionapi
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MaxRichTextContentLength is the maximum number of characters Notion accepts
+// in the content of a single rich text object. Notion counts UTF-16 code
+// units, so characters outside the Basic Multilingual Plane, like most
+// emoji, count twice.
+//
+// See https://developers.notion.com/reference/request-limits#limits-for-property-values
+const MaxRichTextContentLength = 2000
+
+// RichTextBuilder builds a []RichText fluently. Every call to Text, Mention,
+// MentionPage, MentionDatabase, MentionDate or Equation starts a new rich text
+// object, and the annotation methods (Bold, Italic, Color, Link, ...) apply to
+// the most recently started one.
+//
+//	rt := notionapi.NewRichTextBuilder().
+//		Text("Read the ").
+//		Text("docs").Bold().Link("https://developers.notion.com").
+//		Build()
+type RichTextBuilder struct {
+	items []RichText
+}
+
+// NewRichTextBuilder returns an empty RichTextBuilder.
+func NewRichTextBuilder() *RichTextBuilder {
+	return &RichTextBuilder{}
+}
+
+// Text starts a new text object with the given content.
+func (b *RichTextBuilder) Text(content string) *RichTextBuilder {
+	b.items = append(b.items, RichText{
+		Type: RichTextTypeText,
+		Text: &Text{Content: content},
+	})
+	return b
+}
+
+// Mention starts a new user mention.
+func (b *RichTextBuilder) Mention(user UserID) *RichTextBuilder {
+	return b.mention(&Mention{
+		Type: MentionTypeUser,
+		User: &User{Object: ObjectTypeUser, ID: user},
+	})
+}
+
+// MentionPage starts a new page mention.
+func (b *RichTextBuilder) MentionPage(id PageID) *RichTextBuilder {
+	return b.mention(&Mention{
+		Type: MentionTypePage,
+		Page: &PageMention{ID: ObjectID(id)},
+	})
+}
+
+// MentionDatabase starts a new database mention.
+func (b *RichTextBuilder) MentionDatabase(id DatabaseID) *RichTextBuilder {
+	return b.mention(&Mention{
+		Type:     MentionTypeDatabase,
+		Database: &DatabaseMention{ID: ObjectID(id)},
+	})
+}
+
+// MentionDate starts a new date mention. end may be nil.
+func (b *RichTextBuilder) MentionDate(start Date, end *Date) *RichTextBuilder {
+	return b.mention(&Mention{
+		Type: MentionTypeDate,
+		Date: &DateObject{Start: &start, End: end},
+	})
+}
+
+func (b *RichTextBuilder) mention(m *Mention) *RichTextBuilder {
+	b.items = append(b.items, RichText{
+		Type:    RichTextTypeMention,
+		Mention: m,
+	})
+	return b
+}
+
+// Equation starts a new inline equation with the given KaTeX expression.
+func (b *RichTextBuilder) Equation(expression string) *RichTextBuilder {
+	b.items = append(b.items, RichText{
+		Type:     RichTextTypeEquation,
+		Equation: &Equation{Expression: expression},
+	})
+	return b
+}
+
+// Bold makes the current rich text object bold.
+func (b *RichTextBuilder) Bold() *RichTextBuilder {
+	b.annotate(func(a *Annotations) { a.Bold = true })
+	return b
+}
+
+// Italic makes the current rich text object italic.
+func (b *RichTextBuilder) Italic() *RichTextBuilder {
+	b.annotate(func(a *Annotations) { a.Italic = true })
+	return b
+}
+
+// Strikethrough strikes the current rich text object through.
+func (b *RichTextBuilder) Strikethrough() *RichTextBuilder {
+	b.annotate(func(a *Annotations) { a.Strikethrough = true })
+	return b
+}
+
+// Underline underlines the current rich text object.
+func (b *RichTextBuilder) Underline() *RichTextBuilder {
+	b.annotate(func(a *Annotations) { a.Underline = true })
+	return b
+}
+
+// Code formats the current rich text object as inline code.
+func (b *RichTextBuilder) Code() *RichTextBuilder {
+	b.annotate(func(a *Annotations) { a.Code = true })
+	return b
+}
+
+// Color sets the color of the current rich text object.
+func (b *RichTextBuilder) Color(color Color) *RichTextBuilder {
+	b.annotate(func(a *Annotations) { a.Color = color })
+	return b
+}
+
+// Link turns the current text object into a link. It has no effect on
+// mentions and equations, which cannot carry links.
+func (b *RichTextBuilder) Link(url string) *RichTextBuilder {
+	if rt := b.last(); rt != nil && rt.Text != nil {
+		rt.Text.Link = &Link{Url: url}
+	}
+	return b
+}
+
+// Build returns the rich text objects built so far.
+func (b *RichTextBuilder) Build() []RichText {
+	result := make([]RichText, len(b.items))
+	copy(result, b.items)
+	return result
+}
+
+func (b *RichTextBuilder) last() *RichText {
+	if len(b.items) == 0 {
+		return nil
+	}
+	return &b.items[len(b.items)-1]
+}
+
+func (b *RichTextBuilder) annotate(fn func(*Annotations)) {
+	rt := b.last()
+	if rt == nil {
+		return
+	}
+	if rt.Annotations == nil {
+		rt.Annotations = &Annotations{}
+	}
+	fn(rt.Annotations)
+}
+
+// PlainText returns the concatenated plain text of the given rich text
+// objects. Objects built locally have no PlainText yet, so it is derived from
+// their content and stored back into the slice.
+func PlainText(richText []RichText) string {
+	var sb strings.Builder
+	for i := range richText {
+		rt := &richText[i]
+		if rt.PlainText == "" {
+			rt.PlainText = plainTextOf(rt)
+		}
+		sb.WriteString(rt.PlainText)
+	}
+	return sb.String()
+}
+
+func plainTextOf(rt *RichText) string {
+	switch {
+	case rt.Text != nil:
+		return rt.Text.Content
+	case rt.Equation != nil:
+		return rt.Equation.Expression
+	case rt.Mention != nil:
+		m := rt.Mention
+		switch {
+		case m.User != nil && m.User.Name != "":
+			return "@" + m.User.Name
+		case m.Date != nil && m.Date.Start != nil:
+			s := m.Date.Start.String()
+			if m.Date.End != nil {
+				s += " → " + m.Date.End.String()
+			}
+			return s
+		}
+	}
+	return ""
+}
+
+// SplitText splits s into segments of at most limit characters, counted in
+// UTF-16 code units like Notion does. When possible segments end after a
+// newline or whitespace so words are kept together. A non-positive limit
+// defaults to MaxRichTextContentLength.
+func SplitText(s string, limit int) []string {
+	if limit <= 0 {
+		limit = MaxRichTextContentLength
+	}
+	if textLength(s) <= limit {
+		return []string{s}
+	}
+
+	var segments []string
+	runes := []rune(s)
+	for remaining := textLength(s); remaining > limit; {
+		cut := breakPoint(runes[:fittingRunes(runes, limit)])
+		segment := string(runes[:cut])
+		segments = append(segments, segment)
+		remaining -= textLength(segment)
+		runes = runes[cut:]
+	}
+	if len(runes) > 0 {
+		segments = append(segments, string(runes))
+	}
+	return segments
+}
+
+// textLength returns the length of s in UTF-16 code units, the unit of
+// Notion's length limits.
+func textLength(s string) int {
+	n := 0
+	for _, r := range s {
+		n += runeLength(r)
+	}
+	return n
+}
+
+func runeLength(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// fittingRunes returns how many leading runes of runes fit into limit UTF-16
+// code units, but at least one so splitting always makes progress.
+func fittingRunes(runes []rune, limit int) int {
+	n, length := 0, 0
+	for _, r := range runes {
+		if length+runeLength(r) > limit {
+			break
+		}
+		length += runeLength(r)
+		n++
+	}
+	if n == 0 {
+		return 1
+	}
+	return n
+}
+
+// breakPoint returns the length of the longest prefix of window ending in a
+// newline or, failing that, whitespace. It returns len(window) if neither is
+// found in the second half of the window.
+func breakPoint(window []rune) int {
+	half := len(window) / 2
+	for i := len(window) - 1; i >= half; i-- {
+		if window[i] == '\n' {
+			return i + 1
+		}
+	}
+	for i := len(window) - 1; i >= half; i-- {
+		if unicode.IsSpace(window[i]) {
+			return i + 1
+		}
+	}
+	return len(window)
+}
+
+// SplitRichText returns a copy of richText in which every text object longer
+// than limit characters is split into several objects sharing its
+// annotations and link. A non-positive limit defaults to
+// MaxRichTextContentLength.
+func SplitRichText(richText []RichText, limit int) []RichText {
+	result := make([]RichText, 0, len(richText))
+	for _, rt := range richText {
+		if rt.Text == nil {
+			result = append(result, rt)
+			continue
+		}
+		segments := SplitText(rt.Text.Content, limit)
+		if len(segments) == 1 {
+			result = append(result, rt)
+			continue
+		}
+		for _, segment := range segments {
+			part := rt
+			text := *rt.Text
+			text.Content = segment
+			part.Text = &text
+			if rt.PlainText != "" {
+				part.PlainText = segment
+			}
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// MergeRichText returns a copy of richText in which adjacent text objects
+// with the same annotations and link are merged into one. Mentions and
+// equations are never merged.
+func MergeRichText(richText []RichText) []RichText {
+	result := make([]RichText, 0, len(richText))
+	for _, rt := range richText {
+		if n := len(result); n > 0 && mergeable(result[n-1], rt) {
+			prev := &result[n-1]
+			text := *prev.Text
+			text.Content += rt.Text.Content
+			prev.Text = &text
+			if prev.PlainText != "" && rt.PlainText != "" {
+				prev.PlainText += rt.PlainText
+			} else {
+				prev.PlainText = ""
+			}
+			continue
+		}
+		result = append(result, rt)
+	}
+	return result
+}
+
+func mergeable(a, b RichText) bool {
+	if a.Text == nil || b.Text == nil || a.Mention != nil || b.Mention != nil {
+		return false
+	}
+	if a.Href != b.Href || linkURL(a.Text.Link) != linkURL(b.Text.Link) {
+		return false
+	}
+	return annotationsOf(a) == annotationsOf(b)
+}
+
+func linkURL(l *Link) string {
+	if l == nil {
+		return ""
+	}
+	return l.Url
+}
+
+func annotationsOf(rt RichText) Annotations {
+	if rt.Annotations == nil {
+		return Annotations{Color: ColorDefault}
+	}
+	a := *rt.Annotations
+	if a.Color == "" {
+		a.Color = ColorDefault
+	}
+	return a
+}
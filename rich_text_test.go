@@ -0,0 +1,196 @@
+package notionapi_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tenz-io/notionapi"
+)
+
+func TestRichTextBuilder(t *testing.T) {
+	start := notionapi.Date(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name string
+		got  []notionapi.RichText
+		want string
+	}{
+		{
+			name: "text with annotations and link",
+			got: notionapi.NewRichTextBuilder().
+				Text("plain ").
+				Text("docs").Bold().Italic().Color(notionapi.ColorRed).Link("https://example.com").
+				Build(),
+			want: `[{"type":"text","text":{"content":"plain "}},{"type":"text","text":{"content":"docs","link":{"url":"https://example.com"}},"annotations":{"bold":true,"italic":true,"strikethrough":false,"underline":false,"code":false,"color":"red"}}]`,
+		},
+		{
+			name: "mentions",
+			got: notionapi.NewRichTextBuilder().
+				Mention("user_id").
+				MentionPage("page_id").
+				MentionDatabase("db_id").
+				MentionDate(start, nil).
+				Build(),
+			want: `[{"type":"mention","mention":{"type":"user","user":{"object":"user","id":"user_id"}}},{"type":"mention","mention":{"type":"page","page":{"id":"page_id"}}},{"type":"mention","mention":{"type":"database","database":{"id":"db_id"}}},{"type":"mention","mention":{"type":"date","date":{"start":"2024-01-02T00:00:00Z","end":null}}}]`,
+		},
+		{
+			name: "equation ignores link",
+			got:  notionapi.NewRichTextBuilder().Equation("E=mc^2").Code().Link("https://example.com").Build(),
+			want: `[{"type":"equation","equation":{"expression":"E=mc^2"},"annotations":{"bold":false,"italic":false,"strikethrough":false,"underline":false,"code":true}}]`,
+		},
+		{
+			name: "annotation without object is a no-op",
+			got:  notionapi.NewRichTextBuilder().Bold().Build(),
+			want: `[]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Build() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	rt := notionapi.NewRichTextBuilder().
+		Text("a + b = ").
+		Equation("c").
+		Build()
+	rt = append(rt, notionapi.RichText{PlainText: "!", Text: &notionapi.Text{Content: "ignored"}})
+
+	if got := notionapi.PlainText(rt); got != "a + b = c!" {
+		t.Errorf("PlainText() got = %q, want %q", got, "a + b = c!")
+	}
+	if rt[0].PlainText != "a + b = " || rt[1].PlainText != "c" {
+		t.Errorf("PlainText() did not fill in PlainText: %+v", rt)
+	}
+}
+
+func TestSplitText(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "short text is kept",
+			s:     "hello",
+			limit: 10,
+			want:  []string{"hello"},
+		},
+		{
+			name:  "splits on whitespace",
+			s:     "hello brave new world",
+			limit: 12,
+			want:  []string{"hello brave ", "new world"},
+		},
+		{
+			name:  "prefers newlines",
+			s:     "abcdef\ngh ij",
+			limit: 10,
+			want:  []string{"abcdef\n", "gh ij"},
+		},
+		{
+			name:  "hard split without whitespace",
+			s:     "abcdefgh",
+			limit: 3,
+			want:  []string{"abc", "def", "gh"},
+		},
+		{
+			name:  "counts characters, not bytes",
+			s:     "日本語日本語",
+			limit: 3,
+			want:  []string{"日本語", "日本語"},
+		},
+		{
+			name:  "counts emoji as two UTF-16 code units",
+			s:     "😀😀😀😀😀",
+			limit: 4,
+			want:  []string{"😀😀", "😀😀", "😀"},
+		},
+		{
+			name:  "keeps an emoji wider than the limit whole",
+			s:     "😀a",
+			limit: 1,
+			want:  []string{"😀", "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := notionapi.SplitText(tt.s, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitText() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("defaults to the Notion limit", func(t *testing.T) {
+		got := notionapi.SplitText(strings.Repeat("x", notionapi.MaxRichTextContentLength+1), 0)
+		if len(got) != 2 {
+			t.Errorf("SplitText() got %d segments, want 2", len(got))
+		}
+	})
+}
+
+func TestSplitRichText(t *testing.T) {
+	rt := notionapi.NewRichTextBuilder().
+		Text("abcdef").Bold().Link("https://example.com").
+		MentionPage("page_id").
+		Build()
+
+	got := notionapi.SplitRichText(rt, 4)
+	if len(got) != 3 {
+		t.Fatalf("SplitRichText() got %d objects, want 3", len(got))
+	}
+	for i, want := range []string{"abcd", "ef"} {
+		if got[i].Text.Content != want {
+			t.Errorf("SplitRichText()[%d] content = %q, want %q", i, got[i].Text.Content, want)
+		}
+		if !got[i].Annotations.Bold || got[i].Text.Link.Url != "https://example.com" {
+			t.Errorf("SplitRichText()[%d] lost annotations or link", i)
+		}
+	}
+	if rt[0].Text.Content != "abcdef" {
+		t.Errorf("SplitRichText() modified its input")
+	}
+}
+
+func TestMergeRichText(t *testing.T) {
+	rt := notionapi.NewRichTextBuilder().
+		Text("a").
+		Text("b").Color(notionapi.ColorDefault).
+		Text("c").Bold().
+		Text("d").Bold().
+		Text("e").Bold().Link("https://example.com").
+		MentionPage("page_id").
+		Text("f").
+		Build()
+
+	got := notionapi.MergeRichText(rt)
+	var contents []string
+	for _, r := range got {
+		if r.Text != nil {
+			contents = append(contents, r.Text.Content)
+		} else {
+			contents = append(contents, "@")
+		}
+	}
+	want := []string{"ab", "cd", "e", "@", "f"}
+	if !reflect.DeepEqual(contents, want) {
+		t.Errorf("MergeRichText() got = %q, want %q", contents, want)
+	}
+	if rt[0].Text.Content != "a" {
+		t.Errorf("MergeRichText() modified its input")
+	}
+}